@@ -0,0 +1,15 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var keysCmd = &cobra.Command{
+	Use:   "keys",
+	Short: "JWT signing key management",
+	Long:  `Manage the RS256/EdDSA keys in jwt_keys_dir: generate, rotate, list, remove.`,
+}
+
+func init() {
+	apiCmd.AddCommand(keysCmd)
+}
@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/config"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/jwtkeys"
+	"github.com/spf13/cobra"
+)
+
+var (
+	keysDir      string
+	keysAlg      string
+	keysActivate bool
+)
+
+// resolveKeysDir returns the --dir flag or jwt_keys_dir from the config
+func resolveKeysDir() (string, error) {
+	dir := keysDir
+	if dir == "" {
+		if err := config.Load(); err != nil {
+			return "", fmt.Errorf("failed to load config: %w", err)
+		}
+		dir = config.AppConfig.API.JWTKeysDir
+	}
+	if dir == "" {
+		return "", fmt.Errorf("jwt_keys_dir is not configured; pass --dir")
+	}
+	return config.ResolvePath(dir)
+}
+
+var keysGenerateCmd = &cobra.Command{
+	Use:   "generate",
+	Short: "Generate a new signing key",
+	Long:  `Generate a new key. Without --activate it is only published in the JWKS, so verifiers can fetch it before it starts signing.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dir, err := resolveKeysDir()
+		if err != nil {
+			return err
+		}
+		// The first key of a directory is always activated
+		k, err := jwtkeys.Generate(dir, keysAlg, keysActivate || jwtkeys.ActiveID(dir) == "")
+		if err != nil {
+			return err
+		}
+		fmt.Printf("✅ Generated %s key %s in %s\n", k.Algorithm, k.ID, dir)
+		return nil
+	},
+}
+
+var keysRotateCmd = &cobra.Command{
+	Use:   "rotate",
+	Short: "Generate a new signing key and make it active",
+	Long:  `Generate and activate a new key. Previous keys keep verifying tokens until they are removed.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dir, err := resolveKeysDir()
+		if err != nil {
+			return err
+		}
+		k, err := jwtkeys.Generate(dir, keysAlg, true)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("✅ Rotated to %s key %s\n", k.Algorithm, k.ID)
+		fmt.Println("Running servers pick up the new key within a minute.")
+		return nil
+	},
+}
+
+var keysActivateCmd = &cobra.Command{
+	Use:   "activate [kid]",
+	Short: "Make an existing key the signing key",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dir, err := resolveKeysDir()
+		if err != nil {
+			return err
+		}
+		if err := jwtkeys.Activate(dir, args[0]); err != nil {
+			return err
+		}
+		fmt.Printf("✅ Activated key %s\n", args[0])
+		return nil
+	},
+}
+
+var keysListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List signing keys",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dir, err := resolveKeysDir()
+		if err != nil {
+			return err
+		}
+		keys, err := jwtkeys.List(dir)
+		if err != nil {
+			return err
+		}
+		if len(keys) == 0 {
+			fmt.Printf("No keys in %s\n", dir)
+			return nil
+		}
+
+		active := jwtkeys.ActiveID(dir)
+		for _, k := range keys {
+			marker := " "
+			if k.ID == active {
+				marker = "*"
+			}
+			fmt.Printf("%s %-30s %-6s %s\n", marker, k.ID, k.Algorithm, k.CreatedAt.Format("2006-01-02 15:04:05"))
+		}
+		return nil
+	},
+}
+
+var keysRemoveCmd = &cobra.Command{
+	Use:   "remove [kid]",
+	Short: "Remove a retired key",
+	Long:  `Remove a key that no longer needs to verify tokens. Wait at least the access token lifetime after rotating.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dir, err := resolveKeysDir()
+		if err != nil {
+			return err
+		}
+		if err := jwtkeys.Remove(dir, args[0]); err != nil {
+			return err
+		}
+		fmt.Printf("✅ Removed key %s\n", args[0])
+		return nil
+	},
+}
+
+func init() {
+	keysCmd.PersistentFlags().StringVar(&keysDir, "dir", "", "Key directory (defaults to jwt_keys_dir from the config)")
+	keysGenerateCmd.Flags().StringVar(&keysAlg, "alg", jwtkeys.AlgEdDSA, "Algorithm: RS256 or EdDSA")
+	keysGenerateCmd.Flags().BoolVar(&keysActivate, "activate", false, "Make the new key the signing key")
+	keysRotateCmd.Flags().StringVar(&keysAlg, "alg", jwtkeys.AlgEdDSA, "Algorithm: RS256 or EdDSA")
+
+	keysCmd.AddCommand(keysGenerateCmd)
+	keysCmd.AddCommand(keysRotateCmd)
+	keysCmd.AddCommand(keysActivateCmd)
+	keysCmd.AddCommand(keysListCmd)
+	keysCmd.AddCommand(keysRemoveCmd)
+}
@@ -0,0 +1,26 @@
+package audit
+
+import "context"
+
+// Actor identifies who made a change. All fields are empty for changes made
+// by the system, e.g. background jobs or the seeder.
+type Actor struct {
+	UserID    *int
+	Username  string
+	APIKeyID  *int
+	IP        string
+	RequestID string
+}
+
+type actorKey struct{}
+
+// WithActor returns a copy of ctx carrying actor
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFrom returns the actor stored in ctx, or the zero Actor
+func ActorFrom(ctx context.Context) Actor {
+	actor, _ := ctx.Value(actorKey{}).(Actor)
+	return actor
+}
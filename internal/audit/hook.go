@@ -0,0 +1,235 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/address"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/apikey"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/auditlog"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/blog"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/casbinrule"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/category"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/comment"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/role"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/schema"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/tag"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/user"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/softdelete"
+)
+
+// audited lists the recorded entity types with the fields that change too often to be worth recording
+var audited = map[string]map[string]bool{
+	"User":       {"updated_at": true, "failed_login_attempts": true},
+	"Role":       {},
+	"APIKey":     {"last_used_at": true},
+	"CasbinRule": {},
+	"Blog":       {"updated_at": true},
+	"Comment":    {"updated_at": true},
+	"Address":    {"updated_at": true},
+	"Tag":        {},
+	"Category":   {},
+}
+
+// mutation is implemented by every generated mutation type
+type mutation interface {
+	ent.Mutation
+	Client() *ent.Client
+	IDs(ctx context.Context) ([]int, error)
+}
+
+// Hook records creates, updates and deletes of the audited entities together
+// with the actor stored in the context by WithActor.
+// Register it with client.Use so it applies to every mutation.
+func Hook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			ignore, ok := audited[m.Type()]
+			mut, isMut := m.(mutation)
+			if !ok || !isMut {
+				return next.Mutate(ctx, m)
+			}
+
+			// Snapshots must see rows that are or become soft deleted
+			lctx := softdelete.IncludeDeleted(ctx)
+
+			var (
+				ids    []int
+				before = make(map[int]map[string]any)
+			)
+			if !m.Op().Is(ent.OpCreate) {
+				var err error
+				if ids, err = mut.IDs(lctx); err != nil {
+					return nil, err
+				}
+				if before, err = load(lctx, mut.Client(), m.Type(), ids); err != nil {
+					return nil, err
+				}
+			}
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+
+			var op auditlog.Operation
+			after := make(map[int]map[string]any)
+			switch {
+			case m.Op().Is(ent.OpCreate):
+				op = auditlog.OperationCreate
+				snap := snapshot(v)
+				id, _ := snap["id"].(float64)
+				ids = []int{int(id)}
+				after[int(id)] = snap
+			case m.Op().Is(ent.OpUpdateOne):
+				op = auditlog.OperationUpdate
+				after[ids[0]] = snapshot(v)
+			case m.Op().Is(ent.OpUpdate):
+				op = auditlog.OperationUpdate
+				if after, err = load(lctx, mut.Client(), m.Type(), ids); err != nil {
+					return v, err
+				}
+			default:
+				op = auditlog.OperationDelete
+			}
+
+			actor := ActorFrom(ctx)
+			for _, id := range ids {
+				changes := diff(m, before[id], after[id], ignore)
+				if op == auditlog.OperationUpdate && len(changes) == 0 {
+					continue
+				}
+
+				// The change itself succeeded, so a failed write is reported but not returned
+				err := mut.Client().AuditLog.Create().
+					SetNillableActorID(actor.UserID).
+					SetActorUsername(actor.Username).
+					SetNillableAPIKeyID(actor.APIKeyID).
+					SetIP(actor.IP).
+					SetRequestID(actor.RequestID).
+					SetEntityType(m.Type()).
+					SetEntityID(id).
+					SetOperation(op).
+					SetChanges(changes).
+					Exec(ctx)
+				if err != nil {
+					log.Printf("Failed to write audit log for %s %d: %v", m.Type(), id, err)
+				}
+			}
+
+			return v, nil
+		})
+	}
+}
+
+// load returns the snapshots of audited entities by ID, fetched in one query
+func load(ctx context.Context, client *ent.Client, typ string, ids []int) (map[int]map[string]any, error) {
+	snaps := make(map[int]map[string]any, len(ids))
+	if len(ids) == 0 {
+		return snaps, nil
+	}
+
+	var (
+		v   any
+		err error
+	)
+	switch typ {
+	case "User":
+		v, err = client.User.Query().Where(user.IDIn(ids...)).All(ctx)
+	case "Role":
+		v, err = client.Role.Query().Where(role.IDIn(ids...)).All(ctx)
+	case "APIKey":
+		v, err = client.APIKey.Query().Where(apikey.IDIn(ids...)).All(ctx)
+	case "CasbinRule":
+		v, err = client.CasbinRule.Query().Where(casbinrule.IDIn(ids...)).All(ctx)
+	case "Blog":
+		v, err = client.Blog.Query().Where(blog.IDIn(ids...)).All(ctx)
+	case "Comment":
+		v, err = client.Comment.Query().Where(comment.IDIn(ids...)).All(ctx)
+	case "Address":
+		v, err = client.Address.Query().Where(address.IDIn(ids...)).All(ctx)
+	case "Tag":
+		v, err = client.Tag.Query().Where(tag.IDIn(ids...)).All(ctx)
+	case "Category":
+		v, err = client.Category.Query().Where(category.IDIn(ids...)).All(ctx)
+	default:
+		return nil, fmt.Errorf("audit: unsupported entity type %s", typ)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("audit: failed to load %s: %w", typ, err)
+	}
+
+	rows := reflect.ValueOf(v)
+	for i := 0; i < rows.Len(); i++ {
+		snap := snapshot(rows.Index(i).Interface())
+		id, _ := snap["id"].(float64)
+		snaps[int(id)] = snap
+	}
+	return snaps, nil
+}
+
+// snapshot returns the JSON fields of an entity. Sensitive fields are
+// excluded by the generated json:"-" tags.
+func snapshot(v any) map[string]any {
+	var fields map[string]any
+	b, err := json.Marshal(v)
+	if err != nil || json.Unmarshal(b, &fields) != nil {
+		return nil
+	}
+	delete(fields, "edges")
+	return fields
+}
+
+// diff returns the changed fields and edges between two snapshots
+func diff(m ent.Mutation, before, after map[string]any, ignore map[string]bool) map[string]schema.FieldChange {
+	changes := make(map[string]schema.FieldChange)
+
+	for _, snap := range []map[string]any{before, after} {
+		for name := range snap {
+			if name == "id" || ignore[name] {
+				continue
+			}
+			if !reflect.DeepEqual(before[name], after[name]) {
+				changes[name] = schema.FieldChange{Old: before[name], New: after[name]}
+			}
+		}
+	}
+
+	// A field that was set but is missing from both snapshots is sensitive
+	for _, name := range m.Fields() {
+		if ignore[name] {
+			continue
+		}
+		_, inBefore := before[name]
+		_, inAfter := after[name]
+		if value, ok := m.Field(name); ok && !inBefore && !inAfter && !reflect.ValueOf(value).IsZero() {
+			changes[name] = schema.FieldChange{Redacted: true}
+		}
+	}
+
+	for _, name := range m.AddedEdges() {
+		change := changes[name]
+		for _, id := range m.AddedIDs(name) {
+			change.Added = append(change.Added, id)
+		}
+		changes[name] = change
+	}
+	for _, name := range m.RemovedEdges() {
+		change := changes[name]
+		for _, id := range m.RemovedIDs(name) {
+			change.Removed = append(change.Removed, id)
+		}
+		changes[name] = change
+	}
+	for _, name := range m.ClearedEdges() {
+		change := changes[name]
+		change.Cleared = true
+		changes[name] = change
+	}
+
+	return changes
+}
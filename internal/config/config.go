@@ -12,14 +12,48 @@ import (
 type Config struct {
 	API      APIConfig      `mapstructure:"api"`
 	Database DatabaseConfig `mapstructure:"database"`
+	Mail     MailConfig     `mapstructure:"mail"`
 }
 
 type APIConfig struct {
-	Name      string `mapstructure:"name"`
-	Host      string `mapstructure:"host"`
-	Port      int    `mapstructure:"port"`
-	JWTSecret string `mapstructure:"jwt_secret"`
-	JWTExpiry string `mapstructure:"jwt_expiry"`
+	Name          string `mapstructure:"name"`
+	Host          string `mapstructure:"host"`
+	Port          int    `mapstructure:"port"`
+	JWTSecret     string `mapstructure:"jwt_secret"`
+	JWTExpiry     string `mapstructure:"jwt_expiry"`
+	JWTKeysDir    string `mapstructure:"jwt_keys_dir"` // RS256/EdDSA keys; HS256 with jwt_secret when empty
+	RefreshExpiry string `mapstructure:"refresh_expiry"`
+	PublicURL     string `mapstructure:"public_url"` // Base URL used for links in emails
+
+	// Login protection
+	LockoutThreshold int    `mapstructure:"lockout_threshold"`  // Failed logins before an account is locked
+	LockoutDuration  string `mapstructure:"lockout_duration"`   // How long a locked account stays locked
+	LoginBackoffBase string `mapstructure:"login_backoff_base"` // First per-IP delay, doubled on every further failure
+	LoginBackoffMax  string `mapstructure:"login_backoff_max"`  // Upper bound of the per-IP delay
+	RequireAdmin2FA  bool   `mapstructure:"require_admin_2fa"`  // Admins must enroll TOTP before using the API
+
+	// Users, blogs and comments are marked deleted (and restorable) instead of removed
+	SoftDelete bool `mapstructure:"soft_delete"`
+
+	// Deepest reply level below a top-level comment, 0 disables replies
+	CommentMaxDepth int `mapstructure:"comment_max_depth"`
+
+	OIDC OIDCConfig `mapstructure:"oidc"`
+}
+
+// OIDCConfig configures login through an external OpenID Connect provider.
+// Users are created on first login; RoleMapping maps values of RoleClaim
+// (a string or list claim, e.g. "groups") to local roles.
+type OIDCConfig struct {
+	Enabled           bool              `mapstructure:"enabled"`
+	IssuerURL         string            `mapstructure:"issuer_url"`
+	ClientID          string            `mapstructure:"client_id"`
+	ClientSecret      string            `mapstructure:"client_secret"`
+	RedirectURL       string            `mapstructure:"redirect_url"` // Must point at /api/v1/auth/oidc/callback
+	Scopes            []string          `mapstructure:"scopes"`
+	RoleClaim         string            `mapstructure:"role_claim"`
+	RoleMapping       map[string]string `mapstructure:"role_mapping"`
+	PostLoginRedirect string            `mapstructure:"post_login_redirect"` // Tokens are passed in the URL fragment; JSON response when empty
 }
 
 type DatabaseConfig struct {
@@ -37,6 +71,18 @@ type AutoBackupConfig struct {
 	Cron   string `mapstructure:"cron"`
 }
 
+// MailConfig selects and configures the outgoing mail driver.
+// Driver is one of "smtp", "file", "outbox" or "stdout".
+type MailConfig struct {
+	Driver   string `mapstructure:"driver"`
+	From     string `mapstructure:"from"`
+	Host     string `mapstructure:"host"`
+	Port     int    `mapstructure:"port"`
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+	Path     string `mapstructure:"path"` // Output file ("file") or directory ("outbox")
+}
+
 var AppConfig *Config
 
 // Load loads configuration from file or embedded config
@@ -76,3 +122,15 @@ func GetBaseDir() (string, error) {
 	// Return the directory containing the executable
 	return filepath.Dir(execPath), nil
 }
+
+// ResolvePath returns p unchanged if absolute, otherwise relative to the base directory
+func ResolvePath(p string) (string, error) {
+	if filepath.IsAbs(p) {
+		return p, nil
+	}
+	baseDir, err := GetBaseDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(baseDir, p), nil
+}
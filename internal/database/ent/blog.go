@@ -22,10 +22,16 @@ type Blog struct {
 	Title string `json:"title,omitempty"`
 	// Content holds the value of the "content" field.
 	Content string `json:"content,omitempty"`
+	// Status holds the value of the "status" field.
+	Status blog.Status `json:"status,omitempty"`
+	// When the blog went live, or for a draft when it is scheduled to
+	PublishedAt *time.Time `json:"published_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Set when soft deleted, such rows are hidden from queries by default
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the BlogQuery when eager-loading is set.
 	Edges        BlogEdges `json:"edges"`
@@ -39,9 +45,15 @@ type BlogEdges struct {
 	Author *User `json:"author,omitempty"`
 	// Comments holds the value of the comments edge.
 	Comments []*Comment `json:"comments,omitempty"`
+	// Revisions holds the value of the revisions edge.
+	Revisions []*BlogRevision `json:"revisions,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*Tag `json:"tags,omitempty"`
+	// Categories holds the value of the categories edge.
+	Categories []*Category `json:"categories,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [5]bool
 }
 
 // AuthorOrErr returns the Author value or an error if the edge
@@ -64,6 +76,33 @@ func (e BlogEdges) CommentsOrErr() ([]*Comment, error) {
 	return nil, &NotLoadedError{edge: "comments"}
 }
 
+// RevisionsOrErr returns the Revisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e BlogEdges) RevisionsOrErr() ([]*BlogRevision, error) {
+	if e.loadedTypes[2] {
+		return e.Revisions, nil
+	}
+	return nil, &NotLoadedError{edge: "revisions"}
+}
+
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e BlogEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[3] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
+// CategoriesOrErr returns the Categories value or an error if the edge
+// was not loaded in eager-loading.
+func (e BlogEdges) CategoriesOrErr() ([]*Category, error) {
+	if e.loadedTypes[4] {
+		return e.Categories, nil
+	}
+	return nil, &NotLoadedError{edge: "categories"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Blog) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -71,9 +110,9 @@ func (*Blog) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case blog.FieldID:
 			values[i] = new(sql.NullInt64)
-		case blog.FieldTitle, blog.FieldContent:
+		case blog.FieldTitle, blog.FieldContent, blog.FieldStatus:
 			values[i] = new(sql.NullString)
-		case blog.FieldCreatedAt, blog.FieldUpdatedAt:
+		case blog.FieldPublishedAt, blog.FieldCreatedAt, blog.FieldUpdatedAt, blog.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		case blog.ForeignKeys[0]: // user_blogs
 			values[i] = new(sql.NullInt64)
@@ -110,6 +149,19 @@ func (_m *Blog) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Content = value.String
 			}
+		case blog.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = blog.Status(value.String)
+			}
+		case blog.FieldPublishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field published_at", values[i])
+			} else if value.Valid {
+				_m.PublishedAt = new(time.Time)
+				*_m.PublishedAt = value.Time
+			}
 		case blog.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -122,6 +174,13 @@ func (_m *Blog) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdatedAt = value.Time
 			}
+		case blog.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
 		case blog.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field user_blogs", value)
@@ -152,6 +211,21 @@ func (_m *Blog) QueryComments() *CommentQuery {
 	return NewBlogClient(_m.config).QueryComments(_m)
 }
 
+// QueryRevisions queries the "revisions" edge of the Blog entity.
+func (_m *Blog) QueryRevisions() *BlogRevisionQuery {
+	return NewBlogClient(_m.config).QueryRevisions(_m)
+}
+
+// QueryTags queries the "tags" edge of the Blog entity.
+func (_m *Blog) QueryTags() *TagQuery {
+	return NewBlogClient(_m.config).QueryTags(_m)
+}
+
+// QueryCategories queries the "categories" edge of the Blog entity.
+func (_m *Blog) QueryCategories() *CategoryQuery {
+	return NewBlogClient(_m.config).QueryCategories(_m)
+}
+
 // Update returns a builder for updating this Blog.
 // Note that you need to call Blog.Unwrap() before calling this method if this Blog
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -181,11 +255,24 @@ func (_m *Blog) String() string {
 	builder.WriteString("content=")
 	builder.WriteString(_m.Content)
 	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.PublishedAt; v != nil {
+		builder.WriteString("published_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -11,7 +11,10 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/blog"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/blogrevision"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/category"
 	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/comment"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/tag"
 	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/user"
 )
 
@@ -34,6 +37,34 @@ func (_c *BlogCreate) SetContent(v string) *BlogCreate {
 	return _c
 }
 
+// SetStatus sets the "status" field.
+func (_c *BlogCreate) SetStatus(v blog.Status) *BlogCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *BlogCreate) SetNillableStatus(v *blog.Status) *BlogCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (_c *BlogCreate) SetPublishedAt(v time.Time) *BlogCreate {
+	_c.mutation.SetPublishedAt(v)
+	return _c
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (_c *BlogCreate) SetNillablePublishedAt(v *time.Time) *BlogCreate {
+	if v != nil {
+		_c.SetPublishedAt(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *BlogCreate) SetCreatedAt(v time.Time) *BlogCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -62,6 +93,20 @@ func (_c *BlogCreate) SetNillableUpdatedAt(v *time.Time) *BlogCreate {
 	return _c
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *BlogCreate) SetDeletedAt(v time.Time) *BlogCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *BlogCreate) SetNillableDeletedAt(v *time.Time) *BlogCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
 // SetAuthorID sets the "author" edge to the User entity by ID.
 func (_c *BlogCreate) SetAuthorID(id int) *BlogCreate {
 	_c.mutation.SetAuthorID(id)
@@ -88,6 +133,51 @@ func (_c *BlogCreate) AddComments(v ...*Comment) *BlogCreate {
 	return _c.AddCommentIDs(ids...)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the BlogRevision entity by IDs.
+func (_c *BlogCreate) AddRevisionIDs(ids ...int) *BlogCreate {
+	_c.mutation.AddRevisionIDs(ids...)
+	return _c
+}
+
+// AddRevisions adds the "revisions" edges to the BlogRevision entity.
+func (_c *BlogCreate) AddRevisions(v ...*BlogRevision) *BlogCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRevisionIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_c *BlogCreate) AddTagIDs(ids ...int) *BlogCreate {
+	_c.mutation.AddTagIDs(ids...)
+	return _c
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_c *BlogCreate) AddTags(v ...*Tag) *BlogCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTagIDs(ids...)
+}
+
+// AddCategoryIDs adds the "categories" edge to the Category entity by IDs.
+func (_c *BlogCreate) AddCategoryIDs(ids ...int) *BlogCreate {
+	_c.mutation.AddCategoryIDs(ids...)
+	return _c
+}
+
+// AddCategories adds the "categories" edges to the Category entity.
+func (_c *BlogCreate) AddCategories(v ...*Category) *BlogCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCategoryIDs(ids...)
+}
+
 // Mutation returns the BlogMutation object of the builder.
 func (_c *BlogCreate) Mutation() *BlogMutation {
 	return _c.mutation
@@ -123,6 +213,10 @@ func (_c *BlogCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *BlogCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := blog.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := blog.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -151,6 +245,14 @@ func (_c *BlogCreate) check() error {
 			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "Blog.content": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Blog.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := blog.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Blog.status": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Blog.created_at"`)}
 	}
@@ -194,6 +296,14 @@ func (_c *BlogCreate) createSpec() (*Blog, *sqlgraph.CreateSpec) {
 		_spec.SetField(blog.FieldContent, field.TypeString, value)
 		_node.Content = value
 	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(blog.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.PublishedAt(); ok {
+		_spec.SetField(blog.FieldPublishedAt, field.TypeTime, value)
+		_node.PublishedAt = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(blog.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -202,6 +312,10 @@ func (_c *BlogCreate) createSpec() (*Blog, *sqlgraph.CreateSpec) {
 		_spec.SetField(blog.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(blog.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if nodes := _c.mutation.AuthorIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -235,6 +349,54 @@ func (_c *BlogCreate) createSpec() (*Blog, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blog.RevisionsTable,
+			Columns: []string{blog.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   blog.TagsTable,
+			Columns: blog.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CategoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   blog.CategoriesTable,
+			Columns: blog.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -0,0 +1,207 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/blog"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/blogrevision"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/user"
+)
+
+// BlogRevision is the model entity for the BlogRevision schema.
+type BlogRevision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Sequence number within the blog, starting at 1
+	Revision int `json:"revision,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Content holds the value of the "content" field.
+	Content string `json:"content,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BlogRevisionQuery when eager-loading is set.
+	Edges               BlogRevisionEdges `json:"edges"`
+	blog_revisions      *int
+	user_blog_revisions *int
+	selectValues        sql.SelectValues
+}
+
+// BlogRevisionEdges holds the relations/edges for other nodes in the graph.
+type BlogRevisionEdges struct {
+	// Blog holds the value of the blog edge.
+	Blog *Blog `json:"blog,omitempty"`
+	// Editor holds the value of the editor edge.
+	Editor *User `json:"editor,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// BlogOrErr returns the Blog value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BlogRevisionEdges) BlogOrErr() (*Blog, error) {
+	if e.Blog != nil {
+		return e.Blog, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: blog.Label}
+	}
+	return nil, &NotLoadedError{edge: "blog"}
+}
+
+// EditorOrErr returns the Editor value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BlogRevisionEdges) EditorOrErr() (*User, error) {
+	if e.Editor != nil {
+		return e.Editor, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "editor"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BlogRevision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case blogrevision.FieldID, blogrevision.FieldRevision:
+			values[i] = new(sql.NullInt64)
+		case blogrevision.FieldTitle, blogrevision.FieldContent:
+			values[i] = new(sql.NullString)
+		case blogrevision.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case blogrevision.ForeignKeys[0]: // blog_revisions
+			values[i] = new(sql.NullInt64)
+		case blogrevision.ForeignKeys[1]: // user_blog_revisions
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BlogRevision fields.
+func (_m *BlogRevision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case blogrevision.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case blogrevision.FieldRevision:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field revision", values[i])
+			} else if value.Valid {
+				_m.Revision = int(value.Int64)
+			}
+		case blogrevision.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				_m.Title = value.String
+			}
+		case blogrevision.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				_m.Content = value.String
+			}
+		case blogrevision.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case blogrevision.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field blog_revisions", value)
+			} else if value.Valid {
+				_m.blog_revisions = new(int)
+				*_m.blog_revisions = int(value.Int64)
+			}
+		case blogrevision.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_blog_revisions", value)
+			} else if value.Valid {
+				_m.user_blog_revisions = new(int)
+				*_m.user_blog_revisions = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BlogRevision.
+// This includes values selected through modifiers, order, etc.
+func (_m *BlogRevision) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryBlog queries the "blog" edge of the BlogRevision entity.
+func (_m *BlogRevision) QueryBlog() *BlogQuery {
+	return NewBlogRevisionClient(_m.config).QueryBlog(_m)
+}
+
+// QueryEditor queries the "editor" edge of the BlogRevision entity.
+func (_m *BlogRevision) QueryEditor() *UserQuery {
+	return NewBlogRevisionClient(_m.config).QueryEditor(_m)
+}
+
+// Update returns a builder for updating this BlogRevision.
+// Note that you need to call BlogRevision.Unwrap() before calling this method if this BlogRevision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BlogRevision) Update() *BlogRevisionUpdateOne {
+	return NewBlogRevisionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BlogRevision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BlogRevision) Unwrap() *BlogRevision {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BlogRevision is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BlogRevision) String() string {
+	var builder strings.Builder
+	builder.WriteString("BlogRevision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("revision=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Revision))
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(_m.Title)
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(_m.Content)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BlogRevisions is a parsable slice of BlogRevision.
+type BlogRevisions []*BlogRevision
@@ -0,0 +1,325 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/blog"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/blogrevision"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/user"
+)
+
+// BlogRevisionCreate is the builder for creating a BlogRevision entity.
+type BlogRevisionCreate struct {
+	config
+	mutation *BlogRevisionMutation
+	hooks    []Hook
+}
+
+// SetRevision sets the "revision" field.
+func (_c *BlogRevisionCreate) SetRevision(v int) *BlogRevisionCreate {
+	_c.mutation.SetRevision(v)
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *BlogRevisionCreate) SetTitle(v string) *BlogRevisionCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetContent sets the "content" field.
+func (_c *BlogRevisionCreate) SetContent(v string) *BlogRevisionCreate {
+	_c.mutation.SetContent(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BlogRevisionCreate) SetCreatedAt(v time.Time) *BlogRevisionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BlogRevisionCreate) SetNillableCreatedAt(v *time.Time) *BlogRevisionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetBlogID sets the "blog" edge to the Blog entity by ID.
+func (_c *BlogRevisionCreate) SetBlogID(id int) *BlogRevisionCreate {
+	_c.mutation.SetBlogID(id)
+	return _c
+}
+
+// SetBlog sets the "blog" edge to the Blog entity.
+func (_c *BlogRevisionCreate) SetBlog(v *Blog) *BlogRevisionCreate {
+	return _c.SetBlogID(v.ID)
+}
+
+// SetEditorID sets the "editor" edge to the User entity by ID.
+func (_c *BlogRevisionCreate) SetEditorID(id int) *BlogRevisionCreate {
+	_c.mutation.SetEditorID(id)
+	return _c
+}
+
+// SetNillableEditorID sets the "editor" edge to the User entity by ID if the given value is not nil.
+func (_c *BlogRevisionCreate) SetNillableEditorID(id *int) *BlogRevisionCreate {
+	if id != nil {
+		_c = _c.SetEditorID(*id)
+	}
+	return _c
+}
+
+// SetEditor sets the "editor" edge to the User entity.
+func (_c *BlogRevisionCreate) SetEditor(v *User) *BlogRevisionCreate {
+	return _c.SetEditorID(v.ID)
+}
+
+// Mutation returns the BlogRevisionMutation object of the builder.
+func (_c *BlogRevisionCreate) Mutation() *BlogRevisionMutation {
+	return _c.mutation
+}
+
+// Save creates the BlogRevision in the database.
+func (_c *BlogRevisionCreate) Save(ctx context.Context) (*BlogRevision, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BlogRevisionCreate) SaveX(ctx context.Context) *BlogRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BlogRevisionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BlogRevisionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BlogRevisionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := blogrevision.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BlogRevisionCreate) check() error {
+	if _, ok := _c.mutation.Revision(); !ok {
+		return &ValidationError{Name: "revision", err: errors.New(`ent: missing required field "BlogRevision.revision"`)}
+	}
+	if v, ok := _c.mutation.Revision(); ok {
+		if err := blogrevision.RevisionValidator(v); err != nil {
+			return &ValidationError{Name: "revision", err: fmt.Errorf(`ent: validator failed for field "BlogRevision.revision": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "BlogRevision.title"`)}
+	}
+	if v, ok := _c.mutation.Title(); ok {
+		if err := blogrevision.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "BlogRevision.title": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "BlogRevision.content"`)}
+	}
+	if v, ok := _c.mutation.Content(); ok {
+		if err := blogrevision.ContentValidator(v); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "BlogRevision.content": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BlogRevision.created_at"`)}
+	}
+	if len(_c.mutation.BlogIDs()) == 0 {
+		return &ValidationError{Name: "blog", err: errors.New(`ent: missing required edge "BlogRevision.blog"`)}
+	}
+	return nil
+}
+
+func (_c *BlogRevisionCreate) sqlSave(ctx context.Context) (*BlogRevision, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BlogRevisionCreate) createSpec() (*BlogRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BlogRevision{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(blogrevision.Table, sqlgraph.NewFieldSpec(blogrevision.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Revision(); ok {
+		_spec.SetField(blogrevision.FieldRevision, field.TypeInt, value)
+		_node.Revision = value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(blogrevision.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Content(); ok {
+		_spec.SetField(blogrevision.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(blogrevision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.BlogIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blogrevision.BlogTable,
+			Columns: []string{blogrevision.BlogColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.blog_revisions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.EditorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blogrevision.EditorTable,
+			Columns: []string{blogrevision.EditorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_blog_revisions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BlogRevisionCreateBulk is the builder for creating many BlogRevision entities in bulk.
+type BlogRevisionCreateBulk struct {
+	config
+	err      error
+	builders []*BlogRevisionCreate
+}
+
+// Save creates the BlogRevision entities in the database.
+func (_c *BlogRevisionCreateBulk) Save(ctx context.Context) ([]*BlogRevision, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BlogRevision, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BlogRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BlogRevisionCreateBulk) SaveX(ctx context.Context) []*BlogRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BlogRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BlogRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
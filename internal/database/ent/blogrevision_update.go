@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/blogrevision"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/predicate"
+)
+
+// BlogRevisionUpdate is the builder for updating BlogRevision entities.
+type BlogRevisionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BlogRevisionMutation
+}
+
+// Where appends a list predicates to the BlogRevisionUpdate builder.
+func (_u *BlogRevisionUpdate) Where(ps ...predicate.BlogRevision) *BlogRevisionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the BlogRevisionMutation object of the builder.
+func (_u *BlogRevisionUpdate) Mutation() *BlogRevisionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BlogRevisionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BlogRevisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BlogRevisionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BlogRevisionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BlogRevisionUpdate) check() error {
+	if _u.mutation.BlogCleared() && len(_u.mutation.BlogIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BlogRevision.blog"`)
+	}
+	return nil
+}
+
+func (_u *BlogRevisionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(blogrevision.Table, blogrevision.Columns, sqlgraph.NewFieldSpec(blogrevision.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{blogrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BlogRevisionUpdateOne is the builder for updating a single BlogRevision entity.
+type BlogRevisionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BlogRevisionMutation
+}
+
+// Mutation returns the BlogRevisionMutation object of the builder.
+func (_u *BlogRevisionUpdateOne) Mutation() *BlogRevisionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BlogRevisionUpdate builder.
+func (_u *BlogRevisionUpdateOne) Where(ps ...predicate.BlogRevision) *BlogRevisionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BlogRevisionUpdateOne) Select(field string, fields ...string) *BlogRevisionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BlogRevision entity.
+func (_u *BlogRevisionUpdateOne) Save(ctx context.Context) (*BlogRevision, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BlogRevisionUpdateOne) SaveX(ctx context.Context) *BlogRevision {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BlogRevisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BlogRevisionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BlogRevisionUpdateOne) check() error {
+	if _u.mutation.BlogCleared() && len(_u.mutation.BlogIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BlogRevision.blog"`)
+	}
+	return nil
+}
+
+func (_u *BlogRevisionUpdateOne) sqlSave(ctx context.Context) (_node *BlogRevision, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(blogrevision.Table, blogrevision.Columns, sqlgraph.NewFieldSpec(blogrevision.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BlogRevision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, blogrevision.FieldID)
+		for _, f := range fields {
+			if !blogrevision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != blogrevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &BlogRevision{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{blogrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
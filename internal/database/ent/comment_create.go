@@ -28,6 +28,34 @@ func (_c *CommentCreate) SetContent(v string) *CommentCreate {
 	return _c
 }
 
+// SetParentID sets the "parent_id" field.
+func (_c *CommentCreate) SetParentID(v int) *CommentCreate {
+	_c.mutation.SetParentID(v)
+	return _c
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_c *CommentCreate) SetNillableParentID(v *int) *CommentCreate {
+	if v != nil {
+		_c.SetParentID(*v)
+	}
+	return _c
+}
+
+// SetDepth sets the "depth" field.
+func (_c *CommentCreate) SetDepth(v int) *CommentCreate {
+	_c.mutation.SetDepth(v)
+	return _c
+}
+
+// SetNillableDepth sets the "depth" field if the given value is not nil.
+func (_c *CommentCreate) SetNillableDepth(v *int) *CommentCreate {
+	if v != nil {
+		_c.SetDepth(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *CommentCreate) SetCreatedAt(v time.Time) *CommentCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -56,6 +84,34 @@ func (_c *CommentCreate) SetNillableUpdatedAt(v *time.Time) *CommentCreate {
 	return _c
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *CommentCreate) SetDeletedAt(v time.Time) *CommentCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *CommentCreate) SetNillableDeletedAt(v *time.Time) *CommentCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetTombstonedAt sets the "tombstoned_at" field.
+func (_c *CommentCreate) SetTombstonedAt(v time.Time) *CommentCreate {
+	_c.mutation.SetTombstonedAt(v)
+	return _c
+}
+
+// SetNillableTombstonedAt sets the "tombstoned_at" field if the given value is not nil.
+func (_c *CommentCreate) SetNillableTombstonedAt(v *time.Time) *CommentCreate {
+	if v != nil {
+		_c.SetTombstonedAt(*v)
+	}
+	return _c
+}
+
 // SetBlogID sets the "blog" edge to the Blog entity by ID.
 func (_c *CommentCreate) SetBlogID(id int) *CommentCreate {
 	_c.mutation.SetBlogID(id)
@@ -73,11 +129,39 @@ func (_c *CommentCreate) SetAuthorID(id int) *CommentCreate {
 	return _c
 }
 
+// SetNillableAuthorID sets the "author" edge to the User entity by ID if the given value is not nil.
+func (_c *CommentCreate) SetNillableAuthorID(id *int) *CommentCreate {
+	if id != nil {
+		_c = _c.SetAuthorID(*id)
+	}
+	return _c
+}
+
 // SetAuthor sets the "author" edge to the User entity.
 func (_c *CommentCreate) SetAuthor(v *User) *CommentCreate {
 	return _c.SetAuthorID(v.ID)
 }
 
+// SetParent sets the "parent" edge to the Comment entity.
+func (_c *CommentCreate) SetParent(v *Comment) *CommentCreate {
+	return _c.SetParentID(v.ID)
+}
+
+// AddReplyIDs adds the "replies" edge to the Comment entity by IDs.
+func (_c *CommentCreate) AddReplyIDs(ids ...int) *CommentCreate {
+	_c.mutation.AddReplyIDs(ids...)
+	return _c
+}
+
+// AddReplies adds the "replies" edges to the Comment entity.
+func (_c *CommentCreate) AddReplies(v ...*Comment) *CommentCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReplyIDs(ids...)
+}
+
 // Mutation returns the CommentMutation object of the builder.
 func (_c *CommentCreate) Mutation() *CommentMutation {
 	return _c.mutation
@@ -113,6 +197,10 @@ func (_c *CommentCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *CommentCreate) defaults() {
+	if _, ok := _c.mutation.Depth(); !ok {
+		v := comment.DefaultDepth
+		_c.mutation.SetDepth(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := comment.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -133,6 +221,14 @@ func (_c *CommentCreate) check() error {
 			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "Comment.content": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Depth(); !ok {
+		return &ValidationError{Name: "depth", err: errors.New(`ent: missing required field "Comment.depth"`)}
+	}
+	if v, ok := _c.mutation.Depth(); ok {
+		if err := comment.DepthValidator(v); err != nil {
+			return &ValidationError{Name: "depth", err: fmt.Errorf(`ent: validator failed for field "Comment.depth": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Comment.created_at"`)}
 	}
@@ -142,9 +238,6 @@ func (_c *CommentCreate) check() error {
 	if len(_c.mutation.BlogIDs()) == 0 {
 		return &ValidationError{Name: "blog", err: errors.New(`ent: missing required edge "Comment.blog"`)}
 	}
-	if len(_c.mutation.AuthorIDs()) == 0 {
-		return &ValidationError{Name: "author", err: errors.New(`ent: missing required edge "Comment.author"`)}
-	}
 	return nil
 }
 
@@ -175,6 +268,10 @@ func (_c *CommentCreate) createSpec() (*Comment, *sqlgraph.CreateSpec) {
 		_spec.SetField(comment.FieldContent, field.TypeString, value)
 		_node.Content = value
 	}
+	if value, ok := _c.mutation.Depth(); ok {
+		_spec.SetField(comment.FieldDepth, field.TypeInt, value)
+		_node.Depth = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(comment.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -183,6 +280,14 @@ func (_c *CommentCreate) createSpec() (*Comment, *sqlgraph.CreateSpec) {
 		_spec.SetField(comment.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(comment.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.TombstonedAt(); ok {
+		_spec.SetField(comment.FieldTombstonedAt, field.TypeTime, value)
+		_node.TombstonedAt = &value
+	}
 	if nodes := _c.mutation.BlogIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -217,6 +322,39 @@ func (_c *CommentCreate) createSpec() (*Comment, *sqlgraph.CreateSpec) {
 		_node.user_comments = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.ParentTable,
+			Columns: []string{comment.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ParentID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RepliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.RepliesTable,
+			Columns: []string{comment.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -50,6 +50,46 @@ func (_u *CommentUpdate) SetUpdatedAt(v time.Time) *CommentUpdate {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *CommentUpdate) SetDeletedAt(v time.Time) *CommentUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *CommentUpdate) SetNillableDeletedAt(v *time.Time) *CommentUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *CommentUpdate) ClearDeletedAt() *CommentUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetTombstonedAt sets the "tombstoned_at" field.
+func (_u *CommentUpdate) SetTombstonedAt(v time.Time) *CommentUpdate {
+	_u.mutation.SetTombstonedAt(v)
+	return _u
+}
+
+// SetNillableTombstonedAt sets the "tombstoned_at" field if the given value is not nil.
+func (_u *CommentUpdate) SetNillableTombstonedAt(v *time.Time) *CommentUpdate {
+	if v != nil {
+		_u.SetTombstonedAt(*v)
+	}
+	return _u
+}
+
+// ClearTombstonedAt clears the value of the "tombstoned_at" field.
+func (_u *CommentUpdate) ClearTombstonedAt() *CommentUpdate {
+	_u.mutation.ClearTombstonedAt()
+	return _u
+}
+
 // SetBlogID sets the "blog" edge to the Blog entity by ID.
 func (_u *CommentUpdate) SetBlogID(id int) *CommentUpdate {
 	_u.mutation.SetBlogID(id)
@@ -67,11 +107,34 @@ func (_u *CommentUpdate) SetAuthorID(id int) *CommentUpdate {
 	return _u
 }
 
+// SetNillableAuthorID sets the "author" edge to the User entity by ID if the given value is not nil.
+func (_u *CommentUpdate) SetNillableAuthorID(id *int) *CommentUpdate {
+	if id != nil {
+		_u = _u.SetAuthorID(*id)
+	}
+	return _u
+}
+
 // SetAuthor sets the "author" edge to the User entity.
 func (_u *CommentUpdate) SetAuthor(v *User) *CommentUpdate {
 	return _u.SetAuthorID(v.ID)
 }
 
+// AddReplyIDs adds the "replies" edge to the Comment entity by IDs.
+func (_u *CommentUpdate) AddReplyIDs(ids ...int) *CommentUpdate {
+	_u.mutation.AddReplyIDs(ids...)
+	return _u
+}
+
+// AddReplies adds the "replies" edges to the Comment entity.
+func (_u *CommentUpdate) AddReplies(v ...*Comment) *CommentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReplyIDs(ids...)
+}
+
 // Mutation returns the CommentMutation object of the builder.
 func (_u *CommentUpdate) Mutation() *CommentMutation {
 	return _u.mutation
@@ -89,6 +152,27 @@ func (_u *CommentUpdate) ClearAuthor() *CommentUpdate {
 	return _u
 }
 
+// ClearReplies clears all "replies" edges to the Comment entity.
+func (_u *CommentUpdate) ClearReplies() *CommentUpdate {
+	_u.mutation.ClearReplies()
+	return _u
+}
+
+// RemoveReplyIDs removes the "replies" edge to Comment entities by IDs.
+func (_u *CommentUpdate) RemoveReplyIDs(ids ...int) *CommentUpdate {
+	_u.mutation.RemoveReplyIDs(ids...)
+	return _u
+}
+
+// RemoveReplies removes "replies" edges to Comment entities.
+func (_u *CommentUpdate) RemoveReplies(v ...*Comment) *CommentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReplyIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *CommentUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -135,9 +219,6 @@ func (_u *CommentUpdate) check() error {
 	if _u.mutation.BlogCleared() && len(_u.mutation.BlogIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Comment.blog"`)
 	}
-	if _u.mutation.AuthorCleared() && len(_u.mutation.AuthorIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "Comment.author"`)
-	}
 	return nil
 }
 
@@ -159,6 +240,18 @@ func (_u *CommentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(comment.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(comment.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(comment.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TombstonedAt(); ok {
+		_spec.SetField(comment.FieldTombstonedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TombstonedAtCleared() {
+		_spec.ClearField(comment.FieldTombstonedAt, field.TypeTime)
+	}
 	if _u.mutation.BlogCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -217,6 +310,51 @@ func (_u *CommentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.RepliesTable,
+			Columns: []string{comment.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRepliesIDs(); len(nodes) > 0 && !_u.mutation.RepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.RepliesTable,
+			Columns: []string{comment.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RepliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.RepliesTable,
+			Columns: []string{comment.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{comment.Label}
@@ -257,6 +395,46 @@ func (_u *CommentUpdateOne) SetUpdatedAt(v time.Time) *CommentUpdateOne {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *CommentUpdateOne) SetDeletedAt(v time.Time) *CommentUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *CommentUpdateOne) SetNillableDeletedAt(v *time.Time) *CommentUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *CommentUpdateOne) ClearDeletedAt() *CommentUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetTombstonedAt sets the "tombstoned_at" field.
+func (_u *CommentUpdateOne) SetTombstonedAt(v time.Time) *CommentUpdateOne {
+	_u.mutation.SetTombstonedAt(v)
+	return _u
+}
+
+// SetNillableTombstonedAt sets the "tombstoned_at" field if the given value is not nil.
+func (_u *CommentUpdateOne) SetNillableTombstonedAt(v *time.Time) *CommentUpdateOne {
+	if v != nil {
+		_u.SetTombstonedAt(*v)
+	}
+	return _u
+}
+
+// ClearTombstonedAt clears the value of the "tombstoned_at" field.
+func (_u *CommentUpdateOne) ClearTombstonedAt() *CommentUpdateOne {
+	_u.mutation.ClearTombstonedAt()
+	return _u
+}
+
 // SetBlogID sets the "blog" edge to the Blog entity by ID.
 func (_u *CommentUpdateOne) SetBlogID(id int) *CommentUpdateOne {
 	_u.mutation.SetBlogID(id)
@@ -274,11 +452,34 @@ func (_u *CommentUpdateOne) SetAuthorID(id int) *CommentUpdateOne {
 	return _u
 }
 
+// SetNillableAuthorID sets the "author" edge to the User entity by ID if the given value is not nil.
+func (_u *CommentUpdateOne) SetNillableAuthorID(id *int) *CommentUpdateOne {
+	if id != nil {
+		_u = _u.SetAuthorID(*id)
+	}
+	return _u
+}
+
 // SetAuthor sets the "author" edge to the User entity.
 func (_u *CommentUpdateOne) SetAuthor(v *User) *CommentUpdateOne {
 	return _u.SetAuthorID(v.ID)
 }
 
+// AddReplyIDs adds the "replies" edge to the Comment entity by IDs.
+func (_u *CommentUpdateOne) AddReplyIDs(ids ...int) *CommentUpdateOne {
+	_u.mutation.AddReplyIDs(ids...)
+	return _u
+}
+
+// AddReplies adds the "replies" edges to the Comment entity.
+func (_u *CommentUpdateOne) AddReplies(v ...*Comment) *CommentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReplyIDs(ids...)
+}
+
 // Mutation returns the CommentMutation object of the builder.
 func (_u *CommentUpdateOne) Mutation() *CommentMutation {
 	return _u.mutation
@@ -296,6 +497,27 @@ func (_u *CommentUpdateOne) ClearAuthor() *CommentUpdateOne {
 	return _u
 }
 
+// ClearReplies clears all "replies" edges to the Comment entity.
+func (_u *CommentUpdateOne) ClearReplies() *CommentUpdateOne {
+	_u.mutation.ClearReplies()
+	return _u
+}
+
+// RemoveReplyIDs removes the "replies" edge to Comment entities by IDs.
+func (_u *CommentUpdateOne) RemoveReplyIDs(ids ...int) *CommentUpdateOne {
+	_u.mutation.RemoveReplyIDs(ids...)
+	return _u
+}
+
+// RemoveReplies removes "replies" edges to Comment entities.
+func (_u *CommentUpdateOne) RemoveReplies(v ...*Comment) *CommentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReplyIDs(ids...)
+}
+
 // Where appends a list predicates to the CommentUpdate builder.
 func (_u *CommentUpdateOne) Where(ps ...predicate.Comment) *CommentUpdateOne {
 	_u.mutation.Where(ps...)
@@ -355,9 +577,6 @@ func (_u *CommentUpdateOne) check() error {
 	if _u.mutation.BlogCleared() && len(_u.mutation.BlogIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Comment.blog"`)
 	}
-	if _u.mutation.AuthorCleared() && len(_u.mutation.AuthorIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "Comment.author"`)
-	}
 	return nil
 }
 
@@ -396,6 +615,18 @@ func (_u *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err er
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(comment.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(comment.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(comment.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TombstonedAt(); ok {
+		_spec.SetField(comment.FieldTombstonedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TombstonedAtCleared() {
+		_spec.ClearField(comment.FieldTombstonedAt, field.TypeTime)
+	}
 	if _u.mutation.BlogCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -454,6 +685,51 @@ func (_u *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.RepliesTable,
+			Columns: []string{comment.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRepliesIDs(); len(nodes) > 0 && !_u.mutation.RepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.RepliesTable,
+			Columns: []string{comment.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RepliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.RepliesTable,
+			Columns: []string{comment.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Comment{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
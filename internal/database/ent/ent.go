@@ -13,8 +13,18 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/address"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/apikey"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/auditlog"
 	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/blog"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/blogrevision"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/casbinrule"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/category"
 	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/comment"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/passwordresettoken"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/refreshtoken"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/revokedtoken"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/role"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/tag"
 	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/user"
 )
 
@@ -76,10 +86,20 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			address.Table: address.ValidColumn,
-			blog.Table:    blog.ValidColumn,
-			comment.Table: comment.ValidColumn,
-			user.Table:    user.ValidColumn,
+			apikey.Table:             apikey.ValidColumn,
+			address.Table:            address.ValidColumn,
+			auditlog.Table:           auditlog.ValidColumn,
+			blog.Table:               blog.ValidColumn,
+			blogrevision.Table:       blogrevision.ValidColumn,
+			casbinrule.Table:         casbinrule.ValidColumn,
+			category.Table:           category.ValidColumn,
+			comment.Table:            comment.ValidColumn,
+			passwordresettoken.Table: passwordresettoken.ValidColumn,
+			refreshtoken.Table:       refreshtoken.ValidColumn,
+			revokedtoken.Table:       revokedtoken.ValidColumn,
+			role.Table:               role.ValidColumn,
+			tag.Table:                tag.ValidColumn,
+			user.Table:               user.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
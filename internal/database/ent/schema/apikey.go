@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// APIKey holds the schema definition for the APIKey entity.
+// Only the SHA-256 hash of the key is stored; the prefix is kept in clear so
+// the owner can tell keys apart.
+type APIKey struct {
+	ent.Schema
+}
+
+// Fields of the APIKey.
+func (APIKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			MaxLen(100),
+		field.String("prefix").
+			NotEmpty(),
+		field.String("key_hash").
+			Unique().
+			Sensitive().
+			NotEmpty(),
+		field.Strings("scopes"),
+		field.Time("last_used_at").
+			Optional().
+			Nillable(),
+		field.Time("expires_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the APIKey.
+func (APIKey) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("api_keys").
+			Unique().
+			Required(),
+	}
+}
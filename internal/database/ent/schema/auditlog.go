@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuditLog holds the schema definition for the AuditLog entity.
+// Rows are written by the audit hook and never updated. The actor is stored
+// by value so entries survive the deletion of the user.
+type AuditLog struct {
+	ent.Schema
+}
+
+// FieldChange is the change of one field or edge in an audit entry.
+// Sensitive fields are only reported as Redacted; edges list the added and removed IDs.
+type FieldChange struct {
+	Old      any   `json:"old,omitempty"`
+	New      any   `json:"new,omitempty"`
+	Redacted bool  `json:"redacted,omitempty"`
+	Added    []any `json:"added,omitempty"`
+	Removed  []any `json:"removed,omitempty"`
+	Cleared  bool  `json:"cleared,omitempty"`
+}
+
+// Fields of the AuditLog.
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("actor_id").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.String("actor_username").
+			Optional().
+			Immutable(),
+		field.Int("api_key_id").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.String("ip").
+			Optional().
+			Immutable(),
+		field.String("request_id").
+			Optional().
+			Immutable(),
+		field.String("entity_type").
+			NotEmpty().
+			Immutable(),
+		field.Int("entity_id").
+			Immutable(),
+		field.Enum("operation").
+			Values("create", "update", "delete").
+			Immutable(),
+		field.JSON("changes", map[string]FieldChange{}).
+			Optional().
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the AuditLog.
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("entity_type", "entity_id"),
+		index.Fields("actor_id"),
+		index.Fields("created_at"),
+	}
+}
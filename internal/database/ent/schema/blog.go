@@ -4,8 +4,10 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Blog holds the schema definition for the Blog entity.
@@ -20,12 +22,23 @@ func (Blog) Fields() []ent.Field {
 			NotEmpty(),
 		field.Text("content").
 			NotEmpty(),
+		field.Enum("status").
+			Values("draft", "published", "archived").
+			Default("draft"),
+		field.Time("published_at").
+			Optional().
+			Nillable().
+			Comment("When the blog went live, or for a draft when it is scheduled to"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
 		field.Time("updated_at").
 			Default(time.Now).
 			UpdateDefault(time.Now),
+		field.Time("deleted_at").
+			Optional().
+			Nillable().
+			Comment("Set when soft deleted, such rows are hidden from queries by default"),
 	}
 }
 
@@ -37,5 +50,19 @@ func (Blog) Edges() []ent.Edge {
 			Unique().
 			Required(),
 		edge.To("comments", Comment.Type),
+		edge.To("revisions", BlogRevision.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("tags", Tag.Type),
+		edge.To("categories", Category.Type),
+	}
+}
+
+// Indexes of the Blog.
+func (Blog) Indexes() []ent.Index {
+	return []ent.Index{
+		// Supports keyset pagination on (created_at, id)
+		index.Fields("created_at"),
+		// Supports the scheduled publisher and status filters
+		index.Fields("status", "published_at"),
 	}
 }
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// BlogRevision holds the schema definition for the BlogRevision entity.
+// A full snapshot of a blog is stored every time its title or content is written.
+type BlogRevision struct {
+	ent.Schema
+}
+
+// Fields of the BlogRevision.
+func (BlogRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("revision").
+			Positive().
+			Immutable().
+			Comment("Sequence number within the blog, starting at 1"),
+		field.String("title").
+			NotEmpty().
+			Immutable(),
+		field.Text("content").
+			NotEmpty().
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the BlogRevision.
+func (BlogRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("blog", Blog.Type).
+			Ref("revisions").
+			Unique().
+			Required().
+			Immutable(),
+		// Unset once the editing user is deleted
+		edge.From("editor", User.Type).
+			Ref("blog_revisions").
+			Unique().
+			Immutable(),
+	}
+}
+
+// Indexes of the BlogRevision.
+func (BlogRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("revision").
+			Edges("blog").
+			Unique(),
+	}
+}
@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CasbinRule holds the schema definition for the CasbinRule entity.
+// Each row is one line of a Casbin policy, e.g. "p, admin, /api/v1/users, GET"
+// is stored as ptype=p, v0=admin, v1=/api/v1/users, v2=GET.
+type CasbinRule struct {
+	ent.Schema
+}
+
+// Fields of the CasbinRule.
+func (CasbinRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("ptype").
+			NotEmpty().
+			MaxLen(10),
+		field.String("v0").
+			Default("").
+			MaxLen(100),
+		field.String("v1").
+			Default("").
+			MaxLen(100),
+		field.String("v2").
+			Default("").
+			MaxLen(100),
+		field.String("v3").
+			Default("").
+			MaxLen(100),
+		field.String("v4").
+			Default("").
+			MaxLen(100),
+		field.String("v5").
+			Default("").
+			MaxLen(100),
+	}
+}
+
+// Indexes of the CasbinRule.
+func (CasbinRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("ptype", "v0", "v1", "v2", "v3", "v4", "v5").
+			Unique(),
+	}
+}
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Category holds the schema definition for the Category entity.
+// Categories are maintained by admins and identified by their slug.
+type Category struct {
+	ent.Schema
+}
+
+// Fields of the Category.
+func (Category) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			MaxLen(100),
+		field.String("slug").
+			Unique().
+			NotEmpty().
+			MaxLen(100),
+		field.String("description").
+			Optional().
+			MaxLen(255),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Category.
+func (Category) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("blogs", Blog.Type).
+			Ref("categories"),
+	}
+}
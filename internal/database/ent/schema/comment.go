@@ -4,11 +4,15 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Comment holds the schema definition for the Comment entity.
+// Replies point at their parent comment on the same blog. Only tombstones
+// lack an author, once their author is hard deleted.
 type Comment struct {
 	ent.Schema
 }
@@ -18,12 +22,29 @@ func (Comment) Fields() []ent.Field {
 	return []ent.Field{
 		field.Text("content").
 			NotEmpty(),
+		field.Int("parent_id").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.Int("depth").
+			Default(0).
+			NonNegative().
+			Immutable().
+			Comment("Number of ancestors, 0 for a top-level comment"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
 		field.Time("updated_at").
 			Default(time.Now).
 			UpdateDefault(time.Now),
+		field.Time("deleted_at").
+			Optional().
+			Nillable().
+			Comment("Set when soft deleted, such rows are hidden from queries by default"),
+		field.Time("tombstoned_at").
+			Optional().
+			Nillable().
+			Comment("Set when deleted while it had replies; it stays in the thread with its content hidden"),
 	}
 }
 
@@ -36,7 +57,20 @@ func (Comment) Edges() []ent.Edge {
 			Required(),
 		edge.From("author", User.Type).
 			Ref("comments").
+			Unique(),
+		edge.To("replies", Comment.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)).
+			From("parent").
 			Unique().
-			Required(),
+			Field("parent_id").
+			Immutable(),
+	}
+}
+
+// Indexes of the Comment.
+func (Comment) Indexes() []ent.Index {
+	return []ent.Index{
+		// Supports keyset pagination on (created_at, id)
+		index.Fields("created_at"),
 	}
 }
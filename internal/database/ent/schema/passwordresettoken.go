@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PasswordResetToken holds the schema definition for the PasswordResetToken entity.
+// Only the SHA-256 hash of the token is stored; a token is single-use and
+// becomes invalid once used_at is set or expires_at has passed.
+type PasswordResetToken struct {
+	ent.Schema
+}
+
+// Fields of the PasswordResetToken.
+func (PasswordResetToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("token_hash").
+			Unique().
+			Sensitive().
+			NotEmpty(),
+		field.Time("expires_at"),
+		field.Time("used_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the PasswordResetToken.
+func (PasswordResetToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("password_reset_tokens").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the PasswordResetToken.
+func (PasswordResetToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("expires_at"),
+	}
+}
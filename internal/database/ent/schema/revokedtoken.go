@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RevokedToken holds the schema definition for the RevokedToken entity.
+// It records the jti of access tokens revoked before their expiry; rows can
+// be purged once expires_at has passed.
+type RevokedToken struct {
+	ent.Schema
+}
+
+// Fields of the RevokedToken.
+func (RevokedToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("jti").
+			Unique().
+			NotEmpty(),
+		field.Time("expires_at"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the RevokedToken.
+func (RevokedToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("expires_at"),
+	}
+}
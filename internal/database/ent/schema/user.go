@@ -4,8 +4,10 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // User holds the schema definition for the User entity.
@@ -25,23 +27,77 @@ func (User) Fields() []ent.Field {
 		field.String("email").
 			Unique().
 			NotEmpty(),
-		field.Enum("role").
-			Values("user", "admin").
-			Default("user"),
+		field.Time("email_verified_at").
+			Optional().
+			Nillable(),
+		field.Time("verification_sent_at").
+			Optional().
+			Nillable().
+			Comment("When the last verification email was sent, resends are refused until a cooldown has passed"),
+		field.Int("failed_login_attempts").
+			Default(0).
+			NonNegative(),
+		field.Time("locked_until").
+			Optional().
+			Nillable(),
+		field.String("totp_secret").
+			Optional().
+			Sensitive(),
+		field.Time("totp_enabled_at").
+			Optional().
+			Nillable(),
+		field.Strings("totp_recovery_codes").
+			Optional().
+			Sensitive().
+			Comment("SHA-256 hashes of the unused recovery codes"),
+		field.Int64("totp_last_step").
+			Default(0).
+			NonNegative().
+			Comment("Time step of the last accepted TOTP code, codes up to it are rejected as replays"),
+		field.String("oidc_issuer").
+			Optional().
+			Nillable(),
+		field.String("oidc_subject").
+			Optional().
+			Nillable(),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
 		field.Time("updated_at").
 			Default(time.Now).
 			UpdateDefault(time.Now),
+		field.Time("deleted_at").
+			Optional().
+			Nillable().
+			Comment("Set when soft deleted, such rows are hidden from queries by default"),
 	}
 }
 
 // Edges of the User.
 func (User) Edges() []ent.Edge {
 	return []ent.Edge{
+		edge.To("roles", Role.Type),
 		edge.To("addresses", Address.Type),
 		edge.To("blogs", Blog.Type),
 		edge.To("comments", Comment.Type),
+		edge.To("refresh_tokens", RefreshToken.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("password_reset_tokens", PasswordResetToken.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("api_keys", APIKey.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("blog_revisions", BlogRevision.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+	}
+}
+
+// Indexes of the User.
+func (User) Indexes() []ent.Index {
+	return []ent.Index{
+		// Supports keyset pagination on (created_at, id)
+		index.Fields("created_at"),
+		// Links an external identity to at most one local user
+		index.Fields("oidc_issuer", "oidc_subject").
+			Unique(),
 	}
 }
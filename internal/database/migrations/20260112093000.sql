@@ -0,0 +1,6 @@
+-- Modify "blogs" table
+ALTER TABLE `blogs` ADD INDEX `blog_created_at` (`created_at`);
+-- Modify "comments" table
+ALTER TABLE `comments` ADD INDEX `comment_created_at` (`created_at`);
+-- Modify "users" table
+ALTER TABLE `users` ADD INDEX `user_created_at` (`created_at`);
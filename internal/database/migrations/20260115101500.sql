@@ -0,0 +1,25 @@
+-- Create "refresh_tokens" table
+CREATE TABLE `refresh_tokens` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `token_hash` varchar(255) NOT NULL,
+  `family` varchar(255) NOT NULL,
+  `expires_at` timestamp NOT NULL,
+  `revoked_at` timestamp NULL,
+  `created_at` timestamp NOT NULL,
+  `user_refresh_tokens` bigint NOT NULL,
+  PRIMARY KEY (`id`),
+  INDEX `refresh_tokens_users_refresh_tokens` (`user_refresh_tokens`),
+  INDEX `refreshtoken_family` (`family`),
+  UNIQUE INDEX `token_hash` (`token_hash`),
+  CONSTRAINT `refresh_tokens_users_refresh_tokens` FOREIGN KEY (`user_refresh_tokens`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "revoked_tokens" table
+CREATE TABLE `revoked_tokens` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `jti` varchar(255) NOT NULL,
+  `expires_at` timestamp NOT NULL,
+  `created_at` timestamp NOT NULL,
+  PRIMARY KEY (`id`),
+  INDEX `revokedtoken_expires_at` (`expires_at`),
+  UNIQUE INDEX `jti` (`jti`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
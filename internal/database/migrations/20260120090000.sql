@@ -0,0 +1,4 @@
+-- Modify "users" table
+ALTER TABLE `users` ADD COLUMN `email_verified_at` timestamp NULL AFTER `role`;
+-- Accounts created before self-registration were created by an admin or the seeder
+UPDATE `users` SET `email_verified_at` = `created_at`;
@@ -0,0 +1,14 @@
+-- Create "password_reset_tokens" table
+CREATE TABLE `password_reset_tokens` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `token_hash` varchar(255) NOT NULL,
+  `expires_at` timestamp NOT NULL,
+  `used_at` timestamp NULL,
+  `created_at` timestamp NOT NULL,
+  `user_password_reset_tokens` bigint NOT NULL,
+  PRIMARY KEY (`id`),
+  INDEX `password_reset_tokens_users_password_reset_tokens` (`user_password_reset_tokens`),
+  INDEX `passwordresettoken_expires_at` (`expires_at`),
+  UNIQUE INDEX `token_hash` (`token_hash`),
+  CONSTRAINT `password_reset_tokens_users_password_reset_tokens` FOREIGN KEY (`user_password_reset_tokens`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
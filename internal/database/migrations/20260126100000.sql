@@ -0,0 +1,2 @@
+-- Modify "users" table
+ALTER TABLE `users` ADD COLUMN `failed_login_attempts` bigint NOT NULL DEFAULT 0 AFTER `email_verified_at`, ADD COLUMN `locked_until` timestamp NULL AFTER `failed_login_attempts`;
@@ -0,0 +1,2 @@
+-- Modify "users" table
+ALTER TABLE `users` ADD COLUMN `totp_secret` varchar(255) NULL AFTER `locked_until`, ADD COLUMN `totp_enabled_at` timestamp NULL AFTER `totp_secret`, ADD COLUMN `totp_recovery_codes` json NULL AFTER `totp_enabled_at`;
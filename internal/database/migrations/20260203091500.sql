@@ -0,0 +1,16 @@
+-- Create "api_keys" table
+CREATE TABLE `api_keys` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `name` varchar(100) NOT NULL,
+  `prefix` varchar(255) NOT NULL,
+  `key_hash` varchar(255) NOT NULL,
+  `scopes` json NOT NULL,
+  `last_used_at` timestamp NULL,
+  `expires_at` timestamp NULL,
+  `created_at` timestamp NOT NULL,
+  `user_api_keys` bigint NOT NULL,
+  PRIMARY KEY (`id`),
+  INDEX `api_keys_users_api_keys` (`user_api_keys`),
+  UNIQUE INDEX `key_hash` (`key_hash`),
+  CONSTRAINT `api_keys_users_api_keys` FOREIGN KEY (`user_api_keys`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,2 @@
+-- Modify "users" table
+ALTER TABLE `users` ADD COLUMN `oidc_issuer` varchar(255) NULL AFTER `totp_recovery_codes`, ADD COLUMN `oidc_subject` varchar(255) NULL AFTER `oidc_issuer`, ADD UNIQUE INDEX `user_oidc_issuer_oidc_subject` (`oidc_issuer`, `oidc_subject`);
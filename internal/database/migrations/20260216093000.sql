@@ -0,0 +1,13 @@
+-- Create "casbin_rules" table
+CREATE TABLE `casbin_rules` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `ptype` varchar(10) NOT NULL,
+  `v0` varchar(100) NOT NULL DEFAULT "",
+  `v1` varchar(100) NOT NULL DEFAULT "",
+  `v2` varchar(100) NOT NULL DEFAULT "",
+  `v3` varchar(100) NOT NULL DEFAULT "",
+  `v4` varchar(100) NOT NULL DEFAULT "",
+  `v5` varchar(100) NOT NULL DEFAULT "",
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `casbinrule_ptype_v0_v1_v2_v3_v4_v5` (`ptype`, `v0`, `v1`, `v2`, `v3`, `v4`, `v5`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
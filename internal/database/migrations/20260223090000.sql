@@ -0,0 +1,42 @@
+-- Create "roles" table
+CREATE TABLE `roles` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `name` varchar(50) NOT NULL,
+  `description` varchar(255) NULL,
+  `created_at` timestamp NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `name` (`name`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "role_parents" table
+CREATE TABLE `role_parents` (
+  `role_id` bigint NOT NULL,
+  `child_id` bigint NOT NULL,
+  PRIMARY KEY (`role_id`, `child_id`),
+  INDEX `role_parents_child_id` (`child_id`),
+  CONSTRAINT `role_parents_child_id` FOREIGN KEY (`child_id`) REFERENCES `roles` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT `role_parents_role_id` FOREIGN KEY (`role_id`) REFERENCES `roles` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "user_roles" table
+CREATE TABLE `user_roles` (
+  `user_id` bigint NOT NULL,
+  `role_id` bigint NOT NULL,
+  PRIMARY KEY (`user_id`, `role_id`),
+  INDEX `user_roles_role_id` (`role_id`),
+  CONSTRAINT `user_roles_role_id` FOREIGN KEY (`role_id`) REFERENCES `roles` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT `user_roles_user_id` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create the built-in roles and carry over the old enum value
+INSERT INTO `roles` (`name`, `description`, `created_at`) VALUES ('user', 'Default role for registered users', NOW()), ('admin', 'Full access', NOW());
+INSERT INTO `user_roles` (`user_id`, `role_id`) SELECT `users`.`id`, `roles`.`id` FROM `users` JOIN `roles` ON `roles`.`name` = `users`.`role`;
+-- Modify "users" table
+ALTER TABLE `users` DROP COLUMN `role`;
+-- Grant the role management endpoints to admins (fresh installs get them from policy.csv)
+INSERT IGNORE INTO `casbin_rules` (`ptype`, `v0`, `v1`, `v2`)
+SELECT `r`.`ptype`, `r`.`v0`, `r`.`v1`, `r`.`v2` FROM (
+  SELECT 'p' AS `ptype`, 'admin' AS `v0`, '/api/v1/roles' AS `v1`, 'GET' AS `v2`
+  UNION ALL SELECT 'p', 'admin', '/api/v1/roles', 'POST'
+  UNION ALL SELECT 'p', 'admin', '/api/v1/roles/:id', 'GET'
+  UNION ALL SELECT 'p', 'admin', '/api/v1/roles/:id', 'PATCH'
+  UNION ALL SELECT 'p', 'admin', '/api/v1/roles/:id', 'DELETE'
+) AS `r`
+WHERE EXISTS (SELECT 1 FROM `casbin_rules`);
@@ -0,0 +1,9 @@
+-- Grant the permission introspection endpoints (fresh installs get them from policy.csv)
+INSERT IGNORE INTO `casbin_rules` (`ptype`, `v0`, `v1`, `v2`)
+SELECT `r`.`ptype`, `r`.`v0`, `r`.`v1`, `r`.`v2` FROM (
+  SELECT 'p' AS `ptype`, 'admin' AS `v0`, '/api/v1/me/permissions' AS `v1`, 'GET' AS `v2`
+  UNION ALL SELECT 'p', 'admin', '/api/v1/authz/check', 'POST'
+  UNION ALL SELECT 'p', 'user', '/api/v1/me/permissions', 'GET'
+  UNION ALL SELECT 'p', 'user', '/api/v1/authz/check', 'POST'
+) AS `r`
+WHERE EXISTS (SELECT 1 FROM `casbin_rules`);
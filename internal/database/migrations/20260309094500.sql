@@ -0,0 +1,22 @@
+-- Create "audit_logs" table
+CREATE TABLE `audit_logs` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `actor_id` bigint NULL,
+  `actor_username` varchar(255) NULL,
+  `api_key_id` bigint NULL,
+  `ip` varchar(255) NULL,
+  `request_id` varchar(255) NULL,
+  `entity_type` varchar(255) NOT NULL,
+  `entity_id` bigint NOT NULL,
+  `operation` enum('create','update','delete') NOT NULL,
+  `changes` json NULL,
+  `created_at` timestamp NOT NULL,
+  PRIMARY KEY (`id`),
+  INDEX `auditlog_actor_id` (`actor_id`),
+  INDEX `auditlog_created_at` (`created_at`),
+  INDEX `auditlog_entity_type_entity_id` (`entity_type`, `entity_id`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Grant the audit log endpoint to admins (fresh installs get it from policy.csv)
+INSERT IGNORE INTO `casbin_rules` (`ptype`, `v0`, `v1`, `v2`)
+SELECT 'p', 'admin', '/api/v1/audit', 'GET' FROM DUAL
+WHERE EXISTS (SELECT 1 FROM `casbin_rules`);
@@ -0,0 +1,16 @@
+-- Modify "blogs" table
+ALTER TABLE `blogs` ADD COLUMN `deleted_at` timestamp NULL AFTER `updated_at`;
+-- Modify "comments" table
+ALTER TABLE `comments` ADD COLUMN `deleted_at` timestamp NULL AFTER `updated_at`;
+-- Modify "users" table
+ALTER TABLE `users` ADD COLUMN `deleted_at` timestamp NULL AFTER `updated_at`;
+-- Grant the restore endpoints (fresh installs get them from policy.csv)
+INSERT IGNORE INTO `casbin_rules` (`ptype`, `v0`, `v1`, `v2`)
+SELECT `r`.`ptype`, `r`.`v0`, `r`.`v1`, `r`.`v2` FROM (
+  SELECT 'p' AS `ptype`, 'admin' AS `v0`, '/api/v1/users/:id/restore' AS `v1`, 'POST' AS `v2`
+  UNION ALL SELECT 'p', 'admin', '/api/v1/blogs/:id/restore', 'POST'
+  UNION ALL SELECT 'p', 'admin', '/api/v1/comments/:id/restore', 'POST'
+  UNION ALL SELECT 'p', 'user', '/api/v1/blogs/:id/restore', 'POST'
+  UNION ALL SELECT 'p', 'user', '/api/v1/comments/:id/restore', 'POST'
+) AS `r`
+WHERE EXISTS (SELECT 1 FROM `casbin_rules`);
@@ -0,0 +1,30 @@
+-- Create "blog_revisions" table
+CREATE TABLE `blog_revisions` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `revision` bigint NOT NULL,
+  `title` varchar(255) NOT NULL,
+  `content` longtext NOT NULL,
+  `created_at` timestamp NOT NULL,
+  `blog_revisions` bigint NOT NULL,
+  `user_blog_revisions` bigint NULL,
+  PRIMARY KEY (`id`),
+  INDEX `blog_revisions_blogs_revisions` (`blog_revisions`),
+  INDEX `blog_revisions_users_blog_revisions` (`user_blog_revisions`),
+  UNIQUE INDEX `blogrevision_revision_blog_revisions` (`revision`, `blog_revisions`),
+  CONSTRAINT `blog_revisions_blogs_revisions` FOREIGN KEY (`blog_revisions`) REFERENCES `blogs` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT `blog_revisions_users_blog_revisions` FOREIGN KEY (`user_blog_revisions`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE SET NULL
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Existing blogs start their history with the current version, attributed to the author
+INSERT INTO `blog_revisions` (`revision`, `title`, `content`, `created_at`, `blog_revisions`, `user_blog_revisions`)
+SELECT 1, `title`, `content`, `updated_at`, `id`, `user_blogs` FROM `blogs`;
+-- Grant the revision endpoints (fresh installs get them from policy.csv)
+INSERT IGNORE INTO `casbin_rules` (`ptype`, `v0`, `v1`, `v2`)
+SELECT `r`.`ptype`, `r`.`v0`, `r`.`v1`, `r`.`v2` FROM (
+  SELECT 'p' AS `ptype`, 'admin' AS `v0`, '/api/v1/blogs/:id/revisions' AS `v1`, 'GET' AS `v2`
+  UNION ALL SELECT 'p', 'admin', '/api/v1/blogs/:id/revisions/:rev/diff', 'GET'
+  UNION ALL SELECT 'p', 'admin', '/api/v1/blogs/:id/revisions/:rev/restore', 'POST'
+  UNION ALL SELECT 'p', 'user', '/api/v1/blogs/:id/revisions', 'GET'
+  UNION ALL SELECT 'p', 'user', '/api/v1/blogs/:id/revisions/:rev/diff', 'GET'
+  UNION ALL SELECT 'p', 'user', '/api/v1/blogs/:id/revisions/:rev/restore', 'POST'
+) AS `r`
+WHERE EXISTS (SELECT 1 FROM `casbin_rules`);
@@ -0,0 +1,13 @@
+-- Modify "blogs" table
+ALTER TABLE `blogs` ADD COLUMN `status` enum('draft','published','archived') NOT NULL DEFAULT "draft" AFTER `content`, ADD COLUMN `published_at` timestamp NULL AFTER `status`, ADD INDEX `blog_status_published_at` (`status`, `published_at`);
+-- Blogs used to go live when they were created
+UPDATE `blogs` SET `status` = 'published', `published_at` = `created_at`;
+-- Grant the publishing endpoints (fresh installs get them from policy.csv)
+INSERT IGNORE INTO `casbin_rules` (`ptype`, `v0`, `v1`, `v2`)
+SELECT `r`.`ptype`, `r`.`v0`, `r`.`v1`, `r`.`v2` FROM (
+  SELECT 'p' AS `ptype`, 'admin' AS `v0`, '/api/v1/blogs/:id/publish' AS `v1`, 'POST' AS `v2`
+  UNION ALL SELECT 'p', 'admin', '/api/v1/blogs/:id/unpublish', 'POST'
+  UNION ALL SELECT 'p', 'user', '/api/v1/blogs/:id/publish', 'POST'
+  UNION ALL SELECT 'p', 'user', '/api/v1/blogs/:id/unpublish', 'POST'
+) AS `r`
+WHERE EXISTS (SELECT 1 FROM `casbin_rules`);
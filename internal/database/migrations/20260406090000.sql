@@ -0,0 +1,58 @@
+-- Create "categories" table
+CREATE TABLE `categories` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `name` varchar(100) NOT NULL,
+  `slug` varchar(100) NOT NULL,
+  `description` varchar(255) NULL,
+  `created_at` timestamp NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `slug` (`slug`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "tags" table
+CREATE TABLE `tags` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `name` varchar(50) NOT NULL,
+  `slug` varchar(50) NOT NULL,
+  `created_at` timestamp NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `slug` (`slug`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "blog_categories" table
+CREATE TABLE `blog_categories` (
+  `blog_id` bigint NOT NULL,
+  `category_id` bigint NOT NULL,
+  PRIMARY KEY (`blog_id`, `category_id`),
+  INDEX `blog_categories_category_id` (`category_id`),
+  CONSTRAINT `blog_categories_blog_id` FOREIGN KEY (`blog_id`) REFERENCES `blogs` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT `blog_categories_category_id` FOREIGN KEY (`category_id`) REFERENCES `categories` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "blog_tags" table
+CREATE TABLE `blog_tags` (
+  `blog_id` bigint NOT NULL,
+  `tag_id` bigint NOT NULL,
+  PRIMARY KEY (`blog_id`, `tag_id`),
+  INDEX `blog_tags_tag_id` (`tag_id`),
+  CONSTRAINT `blog_tags_blog_id` FOREIGN KEY (`blog_id`) REFERENCES `blogs` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT `blog_tags_tag_id` FOREIGN KEY (`tag_id`) REFERENCES `tags` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Grant the tag and category endpoints (fresh installs get them from policy.csv)
+INSERT IGNORE INTO `casbin_rules` (`ptype`, `v0`, `v1`, `v2`)
+SELECT `r`.`ptype`, `r`.`v0`, `r`.`v1`, `r`.`v2` FROM (
+  SELECT 'p' AS `ptype`, 'admin' AS `v0`, '/api/v1/tags' AS `v1`, 'GET' AS `v2`
+  UNION ALL SELECT 'p', 'admin', '/api/v1/tags', 'POST'
+  UNION ALL SELECT 'p', 'admin', '/api/v1/tags/counts', 'GET'
+  UNION ALL SELECT 'p', 'admin', '/api/v1/tags/:id', 'GET'
+  UNION ALL SELECT 'p', 'admin', '/api/v1/tags/:id', 'PATCH'
+  UNION ALL SELECT 'p', 'admin', '/api/v1/tags/:id', 'DELETE'
+  UNION ALL SELECT 'p', 'admin', '/api/v1/categories', 'GET'
+  UNION ALL SELECT 'p', 'admin', '/api/v1/categories', 'POST'
+  UNION ALL SELECT 'p', 'admin', '/api/v1/categories/:id', 'GET'
+  UNION ALL SELECT 'p', 'admin', '/api/v1/categories/:id', 'PATCH'
+  UNION ALL SELECT 'p', 'admin', '/api/v1/categories/:id', 'DELETE'
+  UNION ALL SELECT 'p', 'user', '/api/v1/tags', 'GET'
+  UNION ALL SELECT 'p', 'user', '/api/v1/tags/counts', 'GET'
+  UNION ALL SELECT 'p', 'user', '/api/v1/tags/:id', 'GET'
+  UNION ALL SELECT 'p', 'user', '/api/v1/categories', 'GET'
+  UNION ALL SELECT 'p', 'user', '/api/v1/categories/:id', 'GET'
+) AS `r`
+WHERE EXISTS (SELECT 1 FROM `casbin_rules`);
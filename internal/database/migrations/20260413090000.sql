@@ -0,0 +1,9 @@
+-- Modify "comments" table
+ALTER TABLE `comments` ADD COLUMN `depth` bigint NOT NULL DEFAULT 0 AFTER `content`, ADD COLUMN `tombstoned_at` timestamp NULL AFTER `deleted_at`, ADD COLUMN `parent_id` bigint NULL AFTER `blog_comments`, ADD INDEX `comments_comments_replies` (`parent_id`), ADD CONSTRAINT `comments_comments_replies` FOREIGN KEY (`parent_id`) REFERENCES `comments` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Grant the blog comments endpoint (fresh installs get them from policy.csv)
+INSERT IGNORE INTO `casbin_rules` (`ptype`, `v0`, `v1`, `v2`)
+SELECT `r`.`ptype`, `r`.`v0`, `r`.`v1`, `r`.`v2` FROM (
+  SELECT 'p' AS `ptype`, 'admin' AS `v0`, '/api/v1/blogs/:id/comments' AS `v1`, 'GET' AS `v2`
+  UNION ALL SELECT 'p', 'user', '/api/v1/blogs/:id/comments', 'GET'
+) AS `r`
+WHERE EXISTS (SELECT 1 FROM `casbin_rules`);
@@ -0,0 +1,2 @@
+-- Make the address listings admin-only, users see their own via /me/addresses
+DELETE FROM `casbin_rules` WHERE `ptype` = 'p' AND `v0` = 'user' AND `v2` = 'GET' AND `v1` IN ('/api/v1/addresses', '/api/v1/addresses/:id', '/api/v1/users/:id/addresses');
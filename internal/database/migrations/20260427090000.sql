@@ -0,0 +1,4 @@
+-- Modify "comments" table
+ALTER TABLE `comments` DROP FOREIGN KEY `comments_comments_replies`, DROP FOREIGN KEY `comments_users_comments`;
+-- Modify "comments" table
+ALTER TABLE `comments` MODIFY COLUMN `user_comments` bigint NULL, ADD CONSTRAINT `comments_comments_replies` FOREIGN KEY (`parent_id`) REFERENCES `comments` (`id`) ON UPDATE NO ACTION ON DELETE SET NULL, ADD CONSTRAINT `comments_users_comments` FOREIGN KEY (`user_comments`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE SET NULL;
@@ -0,0 +1,2 @@
+-- Modify "users" table
+ALTER TABLE `users` ADD COLUMN `verification_sent_at` timestamp NULL AFTER `email_verified_at`;
@@ -5,11 +5,17 @@ import (
 	_ "embed"
 	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/config"
 	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent"
 	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/blog"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/category"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/role"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/tag"
 	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/user"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/slug"
 	"golang.org/x/crypto/bcrypt"
 
 	_ "github.com/go-sql-driver/mysql"
@@ -19,17 +25,18 @@ import (
 var seedDataJSON []byte
 
 type SeedData struct {
-	Users     []SeedUser    `json:"users"`
-	Addresses []SeedAddress `json:"addresses"`
-	Blogs     []SeedBlog    `json:"blogs"`
-	Comments  []SeedComment `json:"comments"`
+	Users      []SeedUser     `json:"users"`
+	Addresses  []SeedAddress  `json:"addresses"`
+	Categories []SeedCategory `json:"categories"`
+	Blogs      []SeedBlog     `json:"blogs"`
+	Comments   []SeedComment  `json:"comments"`
 }
 
 type SeedUser struct {
-	Username string `json:"username"`
-	Password string `json:"password"`
-	Email    string `json:"email"`
-	Role     string `json:"role"`
+	Username string   `json:"username"`
+	Password string   `json:"password"`
+	Email    string   `json:"email"`
+	Roles    []string `json:"roles"`
 }
 
 type SeedAddress struct {
@@ -40,10 +47,17 @@ type SeedAddress struct {
 	Zip      string `json:"zip"`
 }
 
+type SeedCategory struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
 type SeedBlog struct {
-	AuthorUsername string `json:"author_username"`
-	Title          string `json:"title"`
-	Content        string `json:"content"`
+	AuthorUsername string   `json:"author_username"`
+	Title          string   `json:"title"`
+	Content        string   `json:"content"`
+	Tags           []string `json:"tags"`
+	Categories     []string `json:"categories"` // Category names
 }
 
 type SeedComment struct {
@@ -93,6 +107,14 @@ func SeedDatabase() error {
 			return fmt.Errorf("failed to hash password for %s: %w", u.Username, err)
 		}
 
+		roles, err := client.Role.Query().Where(role.NameIn(u.Roles...)).All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query roles for %s: %w", u.Username, err)
+		}
+		if len(roles) != len(u.Roles) {
+			return fmt.Errorf("unknown role in %v for user %s", u.Roles, u.Username)
+		}
+
 		// Check if user exists
 		existingUser, err := client.User.Query().Where(user.UsernameEQ(u.Username)).Only(ctx)
 		if err != nil && !ent.IsNotFound(err) {
@@ -105,7 +127,8 @@ func SeedDatabase() error {
 			createdUser, err = existingUser.Update().
 				SetPassword(string(hashedPassword)).
 				SetEmail(u.Email).
-				SetRole(user.Role(u.Role)).
+				ClearRoles().
+				AddRoles(roles...).
 				Save(ctx)
 			if err != nil {
 				return fmt.Errorf("failed to update user %s: %w", u.Username, err)
@@ -117,7 +140,8 @@ func SeedDatabase() error {
 				SetUsername(u.Username).
 				SetPassword(string(hashedPassword)).
 				SetEmail(u.Email).
-				SetRole(user.Role(u.Role)).
+				AddRoles(roles...).
+				SetEmailVerifiedAt(time.Now()).
 				Save(ctx)
 			if err != nil {
 				return fmt.Errorf("failed to create user %s: %w", u.Username, err)
@@ -125,7 +149,7 @@ func SeedDatabase() error {
 		}
 
 		userMap[u.Username] = createdUser
-		fmt.Printf("  ✓ Seeded user: %s (%s)\n", u.Username, u.Role)
+		fmt.Printf("  ✓ Seeded user: %s (%s)\n", u.Username, strings.Join(u.Roles, ", "))
 	}
 
 	// Seed addresses
@@ -171,6 +195,45 @@ func SeedDatabase() error {
 		fmt.Printf("  ✓ Seeded address for: %s\n", a.Username)
 	}
 
+	// Seed categories
+	fmt.Println("Seeding categories...")
+	categoryMap := make(map[string]*ent.Category)
+	for _, cat := range seedData.Categories {
+		s := slug.Make(cat.Name, 100)
+
+		// Check if category exists (by slug)
+		existingCategory, err := client.Category.Query().Where(category.SlugEQ(s)).Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return fmt.Errorf("failed to query category '%s': %w", cat.Name, err)
+		}
+
+		var createdCategory *ent.Category
+		if existingCategory != nil {
+			// Update existing category
+			createdCategory, err = existingCategory.Update().
+				SetName(cat.Name).
+				SetDescription(cat.Description).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to update category '%s': %w", cat.Name, err)
+			}
+		} else {
+			// Create new category
+			createdCategory, err = client.Category.
+				Create().
+				SetName(cat.Name).
+				SetSlug(s).
+				SetDescription(cat.Description).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to create category '%s': %w", cat.Name, err)
+			}
+		}
+
+		categoryMap[cat.Name] = createdCategory
+		fmt.Printf("  ✓ Seeded category: %s\n", cat.Name)
+	}
+
 	// Seed blogs
 	fmt.Println("Seeding blogs...")
 	blogMap := make(map[string]*ent.Blog)
@@ -204,10 +267,56 @@ func SeedDatabase() error {
 				SetTitle(b.Title).
 				SetContent(b.Content).
 				SetAuthor(u).
+				SetStatus(blog.StatusPublished).
+				SetPublishedAt(time.Now()).
 				Save(ctx)
 			if err != nil {
 				return fmt.Errorf("failed to create blog '%s': %w", b.Title, err)
 			}
+
+			// Start the revision history like the API does
+			err = client.BlogRevision.
+				Create().
+				SetBlog(createdBlog).
+				SetRevision(1).
+				SetTitle(createdBlog.Title).
+				SetContent(createdBlog.Content).
+				SetEditor(u).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to create revision of blog '%s': %w", b.Title, err)
+			}
+		}
+
+		// Replace tags and categories, creating missing tags
+		tags := make([]*ent.Tag, 0, len(b.Tags))
+		for _, name := range b.Tags {
+			s := slug.Make(name, 50)
+			t, err := client.Tag.Query().Where(tag.SlugEQ(s)).Only(ctx)
+			if ent.IsNotFound(err) {
+				t, err = client.Tag.Create().SetName(name).SetSlug(s).Save(ctx)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to seed tag '%s': %w", name, err)
+			}
+			tags = append(tags, t)
+		}
+		categories := make([]*ent.Category, 0, len(b.Categories))
+		for _, name := range b.Categories {
+			cat, exists := categoryMap[name]
+			if !exists {
+				return fmt.Errorf("category '%s' not found for blog '%s'", name, b.Title)
+			}
+			categories = append(categories, cat)
+		}
+		err = createdBlog.Update().
+			ClearTags().
+			AddTags(tags...).
+			ClearCategories().
+			AddCategories(categories...).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to label blog '%s': %w", b.Title, err)
 		}
 
 		blogMap[b.Title] = createdBlog
@@ -0,0 +1,237 @@
+package jwtkeys
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Supported signing algorithms
+const (
+	AlgRS256 = "RS256"
+	AlgEdDSA = "EdDSA"
+)
+
+// activeFile names the file holding the kid used for signing
+const activeFile = "active"
+
+// ErrNoActiveKey is returned when a key directory has no usable active key
+var ErrNoActiveKey = errors.New("no active signing key")
+
+// Key is a private signing key stored as <kid>.pem (PKCS#8) in the key directory
+type Key struct {
+	ID        string
+	Algorithm string
+	Private   crypto.Signer
+	CreatedAt time.Time
+}
+
+// Public returns the public half of the key
+func (k *Key) Public() crypto.PublicKey {
+	return k.Private.Public()
+}
+
+// Method returns the JWT signing method of the key
+func (k *Key) Method() jwt.SigningMethod {
+	if k.Algorithm == AlgEdDSA {
+		return jwt.SigningMethodEdDSA
+	}
+	return jwt.SigningMethodRS256
+}
+
+// Set holds every key of a directory. All keys verify tokens; only Active signs.
+type Set struct {
+	Active *Key
+	Keys   []*Key // Sorted by creation time, newest first
+}
+
+// Lookup returns the key with the given kid
+func (s *Set) Lookup(kid string) (*Key, bool) {
+	for _, k := range s.Keys {
+		if k.ID == kid {
+			return k, true
+		}
+	}
+	return nil, false
+}
+
+// Load reads all keys of dir and resolves the active one
+func Load(dir string) (*Set, error) {
+	keys, err := List(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := os.ReadFile(filepath.Join(dir, activeFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrNoActiveKey
+		}
+		return nil, fmt.Errorf("failed to read active key id: %w", err)
+	}
+
+	set := &Set{Keys: keys}
+	active, ok := set.Lookup(strings.TrimSpace(string(raw)))
+	if !ok {
+		return nil, fmt.Errorf("%w: %s not found in %s", ErrNoActiveKey, strings.TrimSpace(string(raw)), dir)
+	}
+	set.Active = active
+	return set, nil
+}
+
+// List reads all keys of dir, newest first
+func List(dir string) ([]*Key, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read key directory: %w", err)
+	}
+
+	var keys []*Key
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != ".pem" {
+			continue
+		}
+		k, err := readKey(filepath.Join(dir, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, k)
+	}
+
+	sort.Slice(keys, func(i, j int) bool { return keys[i].CreatedAt.After(keys[j].CreatedAt) })
+	return keys, nil
+}
+
+// ActiveID returns the kid currently used for signing, or "" if none
+func ActiveID(dir string) string {
+	raw, err := os.ReadFile(filepath.Join(dir, activeFile))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(raw))
+}
+
+// Generate creates a new key in dir. With activate set it becomes the signing key.
+func Generate(dir, alg string, activate bool) (*Key, error) {
+	var (
+		priv crypto.Signer
+		err  error
+	)
+	switch alg {
+	case AlgRS256:
+		priv, err = rsa.GenerateKey(rand.Reader, 2048)
+	case AlgEdDSA:
+		_, priv, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		return nil, fmt.Errorf("unsupported algorithm %q (use %s or %s)", alg, AlgRS256, AlgEdDSA)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate key: %w", err)
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(priv)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode key: %w", err)
+	}
+
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return nil, fmt.Errorf("failed to generate key id: %w", err)
+	}
+	now := time.Now()
+	kid := now.UTC().Format("20060102-150405") + "-" + hex.EncodeToString(suffix)
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create key directory: %w", err)
+	}
+	block := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+	if err := os.WriteFile(filepath.Join(dir, kid+".pem"), block, 0o600); err != nil {
+		return nil, fmt.Errorf("failed to write key: %w", err)
+	}
+
+	if activate {
+		if err := Activate(dir, kid); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Key{ID: kid, Algorithm: alg, Private: priv, CreatedAt: now}, nil
+}
+
+// Activate makes kid the signing key of dir
+func Activate(dir, kid string) error {
+	if _, err := os.Stat(filepath.Join(dir, kid+".pem")); err != nil {
+		return fmt.Errorf("key %s not found: %w", kid, err)
+	}
+	// Write and rename so a running server never reads a partial file
+	tmp := filepath.Join(dir, activeFile+".tmp")
+	if err := os.WriteFile(tmp, []byte(kid+"\n"), 0o600); err != nil {
+		return fmt.Errorf("failed to write active key id: %w", err)
+	}
+	if err := os.Rename(tmp, filepath.Join(dir, activeFile)); err != nil {
+		return fmt.Errorf("failed to activate key: %w", err)
+	}
+	return nil
+}
+
+// Remove deletes a key that is no longer needed to verify tokens.
+// The active key cannot be removed.
+func Remove(dir, kid string) error {
+	if kid == ActiveID(dir) {
+		return fmt.Errorf("key %s is active; rotate to a new key first", kid)
+	}
+	if err := os.Remove(filepath.Join(dir, kid+".pem")); err != nil {
+		return fmt.Errorf("failed to remove key %s: %w", kid, err)
+	}
+	return nil
+}
+
+// readKey parses a PKCS#8 PEM private key file
+func readKey(path string) (*Key, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key %s: %w", path, err)
+	}
+	block, _ := pem.Decode(raw)
+	if block == nil {
+		return nil, fmt.Errorf("key %s is not PEM encoded", path)
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse key %s: %w", path, err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	k := &Key{
+		ID:        strings.TrimSuffix(filepath.Base(path), ".pem"),
+		CreatedAt: info.ModTime(),
+	}
+	switch priv := parsed.(type) {
+	case *rsa.PrivateKey:
+		k.Algorithm, k.Private = AlgRS256, priv
+	case ed25519.PrivateKey:
+		k.Algorithm, k.Private = AlgEdDSA, priv
+	default:
+		return nil, fmt.Errorf("key %s has unsupported type %T", path, parsed)
+	}
+	return k, nil
+}
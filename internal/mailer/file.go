@@ -0,0 +1,83 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// WriterMailer writes emails to an io.Writer instead of sending them.
+// Used for local development and testing.
+type WriterMailer struct {
+	from string
+	mu   sync.Mutex
+	w    io.Writer
+}
+
+func NewWriterMailer(from string, w io.Writer) *WriterMailer {
+	return &WriterMailer{from: from, w: w}
+}
+
+func (m *WriterMailer) Send(ctx context.Context, msg Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, err := fmt.Fprintf(m.w, "----- mail -----\r\n%s\r\n----------------\r\n", format(m.from, msg)); err != nil {
+		return fmt.Errorf("mail: failed to write message: %w", err)
+	}
+	return nil
+}
+
+// FileMailer appends emails to a file
+type FileMailer struct {
+	from string
+	path string
+	mu   sync.Mutex
+}
+
+func NewFileMailer(from, path string) *FileMailer {
+	return &FileMailer{from: from, path: path}
+}
+
+func (m *FileMailer) Send(ctx context.Context, msg Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	f, err := os.OpenFile(m.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("mail: failed to open %s: %w", m.path, err)
+	}
+	defer f.Close()
+
+	return NewWriterMailer(m.from, f).Send(ctx, msg)
+}
+
+// OutboxMailer writes each email to its own .eml file in a directory
+type OutboxMailer struct {
+	from string
+	dir  string
+}
+
+func NewOutboxMailer(from, dir string) *OutboxMailer {
+	return &OutboxMailer{from: from, dir: dir}
+}
+
+func (m *OutboxMailer) Send(ctx context.Context, msg Message) error {
+	if err := os.MkdirAll(m.dir, 0o700); err != nil {
+		return fmt.Errorf("mail: failed to create outbox %s: %w", m.dir, err)
+	}
+
+	f, err := os.CreateTemp(m.dir, time.Now().Format("20060102-150405")+"-*.eml")
+	if err != nil {
+		return fmt.Errorf("mail: failed to create message file: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(format(m.from, msg)); err != nil {
+		return fmt.Errorf("mail: failed to write message: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/config"
+)
+
+// Message is a plain-text email
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer sends emails
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// New returns the Mailer selected by cfg.Driver
+func New(cfg config.MailConfig) (Mailer, error) {
+	switch cfg.Driver {
+	case "smtp":
+		if cfg.Host == "" {
+			return nil, fmt.Errorf("mail: smtp driver requires a host")
+		}
+		return NewSMTPMailer(cfg), nil
+	case "file":
+		if cfg.Path == "" {
+			return nil, fmt.Errorf("mail: file driver requires a path")
+		}
+		return NewFileMailer(cfg.From, cfg.Path), nil
+	case "outbox":
+		if cfg.Path == "" {
+			return nil, fmt.Errorf("mail: outbox driver requires a path")
+		}
+		return NewOutboxMailer(cfg.From, cfg.Path), nil
+	case "stdout", "":
+		return NewWriterMailer(cfg.From, os.Stdout), nil
+	default:
+		return nil, fmt.Errorf("mail: unknown driver %q", cfg.Driver)
+	}
+}
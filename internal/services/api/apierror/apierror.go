@@ -0,0 +1,64 @@
+package apierror
+
+import (
+	"fmt"
+
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/dto"
+	"github.com/gofiber/fiber/v2"
+)
+
+// Error is an API error with an HTTP status and a stable machine readable code
+type Error struct {
+	Status  int
+	Code    string
+	Message string
+	Fields  []dto.FieldError
+	cause   error
+}
+
+// Error implements the error interface
+func (e *Error) Error() string {
+	if e.cause != nil {
+		return fmt.Sprintf("%s: %s: %v", e.Code, e.Message, e.cause)
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// Unwrap returns the underlying cause, if any
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+// New creates an API error
+func New(status int, code, message string) *Error {
+	return &Error{Status: status, Code: code, Message: message}
+}
+
+// BadRequest creates a 400 error
+func BadRequest(message string) *Error {
+	return New(fiber.StatusBadRequest, CodeBadRequest, message)
+}
+
+// Unauthorized creates a 401 error
+func Unauthorized(code, message string) *Error {
+	return New(fiber.StatusUnauthorized, code, message)
+}
+
+// Forbidden creates a 403 error
+func Forbidden(message string) *Error {
+	return New(fiber.StatusForbidden, CodeForbidden, message)
+}
+
+// Validation creates a 422 error carrying per-field details
+func Validation(fields []dto.FieldError) *Error {
+	e := New(fiber.StatusUnprocessableEntity, CodeValidationFailed, "Validation failed")
+	e.Fields = fields
+	return e
+}
+
+// Internal creates a 500 error. The cause is logged but never sent to the client.
+func Internal(cause error) *Error {
+	e := New(fiber.StatusInternalServerError, CodeInternal, "Internal server error")
+	e.cause = cause
+	return e
+}
@@ -0,0 +1,98 @@
+package apierror
+
+import "github.com/gofiber/fiber/v2"
+
+// Error codes returned in the "code" field of error responses.
+// Codes are part of the API contract: add new ones, never rename existing ones.
+const (
+	CodeBadRequest         = "BAD_REQUEST"
+	CodeInvalidID          = "INVALID_ID"
+	CodeValidationFailed   = "VALIDATION_FAILED"
+	CodeUnauthorized       = "UNAUTHORIZED"
+	CodeInvalidToken       = "INVALID_TOKEN"
+	CodeTokenRevoked       = "TOKEN_REVOKED"
+	CodeInvalidAPIKey      = "INVALID_API_KEY"
+	CodeInsufficientScope  = "INSUFFICIENT_SCOPE"
+	CodeRefreshInvalid     = "INVALID_REFRESH_TOKEN"
+	CodeRefreshReused      = "REFRESH_TOKEN_REUSED"
+	CodeInvalidCredentials = "INVALID_CREDENTIALS"
+	CodeEmailNotVerified   = "EMAIL_NOT_VERIFIED"
+	CodeInvalidVerifyToken = "INVALID_VERIFICATION_TOKEN"
+	CodeInvalidResetToken  = "INVALID_RESET_TOKEN"
+	CodeAccountLocked      = "ACCOUNT_LOCKED"
+	CodeInvalid2FACode     = "INVALID_2FA_CODE"
+	CodeInvalid2FAToken    = "INVALID_2FA_CHALLENGE"
+	Code2FARequired        = "2FA_ENROLLMENT_REQUIRED"
+	Code2FAEnabled         = "2FA_ALREADY_ENABLED"
+	Code2FANotEnabled      = "2FA_NOT_ENABLED"
+	CodeForbidden          = "FORBIDDEN"
+	CodeNotFound           = "NOT_FOUND"
+	CodeUserNotFound       = "USER_NOT_FOUND"
+	CodeBlogNotFound       = "BLOG_NOT_FOUND"
+	CodeCommentNotFound    = "COMMENT_NOT_FOUND"
+	CodeAddressNotFound    = "ADDRESS_NOT_FOUND"
+	CodeAPIKeyNotFound     = "API_KEY_NOT_FOUND"
+	CodeRoleNotFound       = "ROLE_NOT_FOUND"
+	CodePolicyNotFound     = "POLICY_NOT_FOUND"
+	CodeRevisionNotFound   = "REVISION_NOT_FOUND"
+	CodeTagNotFound        = "TAG_NOT_FOUND"
+	CodeCategoryNotFound   = "CATEGORY_NOT_FOUND"
+	CodePolicyExists       = "POLICY_EXISTS"
+	CodeConflict           = "CONFLICT"
+	CodeTooManyRequests    = "TOO_MANY_REQUESTS"
+	CodeUsernameTaken      = "USERNAME_TAKEN"
+	CodeEmailTaken         = "EMAIL_TAKEN"
+	CodeRoleExists         = "ROLE_EXISTS"
+	CodeTagExists          = "TAG_EXISTS"
+	CodeCategoryExists     = "CATEGORY_EXISTS"
+	CodeInternal           = "INTERNAL_ERROR"
+)
+
+// Common errors shared by handlers and middleware
+var (
+	ErrInvalidID          = New(fiber.StatusBadRequest, CodeInvalidID, "Invalid ID")
+	ErrInvalidCredentials = New(fiber.StatusUnauthorized, CodeInvalidCredentials, "Invalid credentials")
+	ErrEmailNotVerified   = New(fiber.StatusForbidden, CodeEmailNotVerified, "Email address has not been verified")
+	ErrNotFound           = New(fiber.StatusNotFound, CodeNotFound, "Resource not found")
+	ErrUserNotFound       = New(fiber.StatusNotFound, CodeUserNotFound, "User not found")
+	ErrBlogNotFound       = New(fiber.StatusNotFound, CodeBlogNotFound, "Blog not found")
+	ErrCommentNotFound    = New(fiber.StatusNotFound, CodeCommentNotFound, "Comment not found")
+	ErrAddressNotFound    = New(fiber.StatusNotFound, CodeAddressNotFound, "Address not found")
+	ErrAPIKeyNotFound     = New(fiber.StatusNotFound, CodeAPIKeyNotFound, "API key not found")
+	ErrRoleNotFound       = New(fiber.StatusNotFound, CodeRoleNotFound, "Role not found")
+	ErrPolicyNotFound     = New(fiber.StatusNotFound, CodePolicyNotFound, "Policy not found")
+	ErrRevisionNotFound   = New(fiber.StatusNotFound, CodeRevisionNotFound, "Revision not found")
+	ErrTagNotFound        = New(fiber.StatusNotFound, CodeTagNotFound, "Tag not found")
+	ErrCategoryNotFound   = New(fiber.StatusNotFound, CodeCategoryNotFound, "Category not found")
+	ErrPolicyExists       = New(fiber.StatusConflict, CodePolicyExists, "Policy already exists")
+	ErrUsernameTaken      = New(fiber.StatusConflict, CodeUsernameTaken, "Username is already taken")
+	ErrEmailTaken         = New(fiber.StatusConflict, CodeEmailTaken, "Email is already registered")
+	ErrRoleExists         = New(fiber.StatusConflict, CodeRoleExists, "A role with this name already exists")
+	ErrTagExists          = New(fiber.StatusConflict, CodeTagExists, "A tag with this slug already exists")
+	ErrCategoryExists     = New(fiber.StatusConflict, CodeCategoryExists, "A category with this slug already exists")
+)
+
+// codeForStatus returns the generic code used for errors raised by Fiber itself
+func codeForStatus(status int) string {
+	switch status {
+	case fiber.StatusBadRequest:
+		return CodeBadRequest
+	case fiber.StatusUnauthorized:
+		return CodeUnauthorized
+	case fiber.StatusForbidden:
+		return CodeForbidden
+	case fiber.StatusNotFound:
+		return CodeNotFound
+	case fiber.StatusConflict:
+		return CodeConflict
+	case fiber.StatusUnprocessableEntity:
+		return CodeValidationFailed
+	case fiber.StatusTooManyRequests:
+		return CodeTooManyRequests
+	default:
+		if status >= fiber.StatusInternalServerError {
+			return CodeInternal
+		}
+		return CodeBadRequest
+	}
+}
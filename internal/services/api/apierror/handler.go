@@ -0,0 +1,99 @@
+package apierror
+
+import (
+	"errors"
+	"log"
+	"strings"
+
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/dto"
+	"github.com/gofiber/fiber/v2"
+)
+
+// FromEnt maps an Ent error to an API error:
+// not found -> notFound (404), constraint -> 409, validation -> 422, anything else -> 500.
+// A nil notFound falls back to the generic ErrNotFound. API errors, e.g. returned
+// from inside a transaction, pass through unchanged.
+func FromEnt(err error, notFound *Error) error {
+	if err == nil {
+		return nil
+	}
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+
+	switch {
+	case ent.IsNotFound(err):
+		if notFound == nil {
+			return ErrNotFound
+		}
+		return notFound
+	case ent.IsConstraintError(err):
+		return fromConstraint(err)
+	case ent.IsValidationError(err):
+		var verr *ent.ValidationError
+		errors.As(err, &verr)
+		return Validation([]dto.FieldError{{
+			Field:   verr.Name,
+			Rule:    "invalid",
+			Message: verr.Error(),
+		}})
+	default:
+		return Internal(err)
+	}
+}
+
+// fromConstraint maps unique and foreign key violations without leaking the SQL message
+func fromConstraint(err error) *Error {
+	msg := err.Error()
+	switch {
+	case strings.Contains(msg, "Duplicate entry") && strings.Contains(msg, "username"):
+		return ErrUsernameTaken
+	case strings.Contains(msg, "Duplicate entry") && strings.Contains(msg, "email"):
+		return ErrEmailTaken
+	case strings.Contains(msg, "Duplicate entry") && strings.Contains(msg, "roles.name"):
+		return ErrRoleExists
+	case strings.Contains(msg, "Duplicate entry") && strings.Contains(msg, "tags.slug"):
+		return ErrTagExists
+	case strings.Contains(msg, "Duplicate entry") && strings.Contains(msg, "categories.slug"):
+		return ErrCategoryExists
+	case strings.Contains(msg, "foreign key constraint"):
+		return New(fiber.StatusConflict, CodeConflict, "Resource is still referenced by other records")
+	default:
+		return New(fiber.StatusConflict, CodeConflict, "Resource conflicts with an existing record")
+	}
+}
+
+// Handler is the Fiber ErrorHandler that renders every error in the common envelope
+func Handler(c *fiber.Ctx, err error) error {
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		var fiberErr *fiber.Error
+		if errors.As(err, &fiberErr) {
+			apiErr = New(fiberErr.Code, codeForStatus(fiberErr.Code), fiberErr.Message)
+		} else {
+			apiErr = Internal(err)
+		}
+	}
+
+	requestID := RequestID(c)
+	if apiErr.Status >= fiber.StatusInternalServerError {
+		log.Printf("[%s] %s %s: %v", requestID, c.Method(), c.Path(), apiErr)
+	}
+
+	return c.Status(apiErr.Status).JSON(dto.ErrorResponse{
+		Error:     apiErr.Message,
+		Code:      apiErr.Code,
+		RequestID: requestID,
+		Fields:    apiErr.Fields,
+	})
+}
+
+// RequestID returns the ID assigned by the requestid middleware
+func RequestID(c *fiber.Ctx) string {
+	if id, ok := c.Locals("requestid").(string); ok {
+		return id
+	}
+	return c.GetRespHeader(fiber.HeaderXRequestID)
+}
@@ -0,0 +1,33 @@
+package dto
+
+import "time"
+
+// AddressResponse represents address data in API responses
+type AddressResponse struct {
+	ID        int64     `json:"id"`
+	Street    string    `json:"street"`
+	City      string    `json:"city"`
+	State     string    `json:"state"`
+	Zip       string    `json:"zip"`
+	UserID    int64     `json:"user_id"`
+	Username  string    `json:"username,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CreateAddressRequest represents address creation request
+type CreateAddressRequest struct {
+	Street string      `json:"street" validate:"required,max=255"`
+	City   string      `json:"city" validate:"required,max=255"`
+	State  string      `json:"state" validate:"required,max=255"`
+	Zip    string      `json:"zip" validate:"required,max=255"`
+	UserID interface{} `json:"user_id"` // Accept string or int
+}
+
+// UpdateAddressRequest represents address update request
+type UpdateAddressRequest struct {
+	Street *string `json:"street,omitempty" validate:"omitempty,min=1,max=255"`
+	City   *string `json:"city,omitempty" validate:"omitempty,min=1,max=255"`
+	State  *string `json:"state,omitempty" validate:"omitempty,min=1,max=255"`
+	Zip    *string `json:"zip,omitempty" validate:"omitempty,min=1,max=255"`
+}
@@ -0,0 +1,27 @@
+package dto
+
+import "time"
+
+// APIKeyResponse represents an API key in API responses. The key itself is never returned after creation.
+type APIKeyResponse struct {
+	ID         int64      `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// APIKeyCreatedResponse is returned once on creation and includes the key
+type APIKeyCreatedResponse struct {
+	APIKeyResponse
+	Key string `json:"key"`
+}
+
+// CreateAPIKeyRequest represents API key creation request
+type CreateAPIKeyRequest struct {
+	Name      string     `json:"name" validate:"required,max=100"`
+	Scopes    []string   `json:"scopes" validate:"required,min=1,dive,scope"` // One of middleware.APIKeyScopes
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
@@ -0,0 +1,28 @@
+package dto
+
+import "time"
+
+// AuditLogResponse represents an audit log entry in API responses
+type AuditLogResponse struct {
+	ID            int64                  `json:"id"`
+	ActorID       *int64                 `json:"actor_id,omitempty"` // Omitted for system changes
+	ActorUsername string                 `json:"actor_username,omitempty"`
+	APIKeyID      *int64                 `json:"api_key_id,omitempty"` // Set when the actor used an API key
+	IP            string                 `json:"ip,omitempty"`
+	RequestID     string                 `json:"request_id,omitempty"`
+	EntityType    string                 `json:"entity_type"`
+	EntityID      int64                  `json:"entity_id"`
+	Operation     string                 `json:"operation"`
+	Changes       map[string]AuditChange `json:"changes"`
+	CreatedAt     time.Time              `json:"created_at"`
+}
+
+// AuditChange is the change of one field or edge. Sensitive fields only report redacted.
+type AuditChange struct {
+	Old      interface{}   `json:"old,omitempty"`
+	New      interface{}   `json:"new,omitempty"`
+	Redacted bool          `json:"redacted,omitempty"`
+	Added    []interface{} `json:"added,omitempty"`
+	Removed  []interface{} `json:"removed,omitempty"`
+	Cleared  bool          `json:"cleared,omitempty"`
+}
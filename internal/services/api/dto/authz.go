@@ -0,0 +1,39 @@
+package dto
+
+// Permission is an allowed request; Path may be a pattern such as /api/v1/blogs/:id
+type Permission struct {
+	Path   string `json:"path"`
+	Method string `json:"method"`
+}
+
+// PermissionsResponse lists what the current token may do. Capabilities groups
+// the permissions by resource (e.g. "blogs": ["read", "create"]).
+// Ownership rules still apply: "update" on blogs does not cover other users' blogs unless admin.
+type PermissionsResponse struct {
+	Roles        []string            `json:"roles"`
+	Permissions  []Permission        `json:"permissions"`
+	Capabilities map[string][]string `json:"capabilities"`
+}
+
+// AuthzCheckRequest asks whether the current token may make each request
+type AuthzCheckRequest struct {
+	Checks []AuthzCheck `json:"checks" validate:"required,min=1,max=100,dive"`
+}
+
+// AuthzCheck is a single request to check, e.g. {"path": "/api/v1/blogs/12", "method": "DELETE"}
+type AuthzCheck struct {
+	Path   string `json:"path" validate:"required,startswith=/"`
+	Method string `json:"method" validate:"required,oneof=GET POST PUT PATCH DELETE"`
+}
+
+// AuthzCheckResult is the answer for one check, in request order
+type AuthzCheckResult struct {
+	Path    string `json:"path"`
+	Method  string `json:"method"`
+	Allowed bool   `json:"allowed"`
+}
+
+// AuthzCheckResponse holds the results of a batch check
+type AuthzCheckResponse struct {
+	Results []AuthzCheckResult `json:"results"`
+}
@@ -4,24 +4,67 @@ import "time"
 
 // BlogResponse represents blog data in API responses
 type BlogResponse struct {
-	ID        int64     `json:"id"`
-	Title     string    `json:"title"`
-	Content   string    `json:"content"`
-	UserID    int64     `json:"user_id"`
-	Username  string    `json:"username,omitempty"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID          int64      `json:"id"`
+	Title       string     `json:"title"`
+	Content     string     `json:"content"`
+	UserID      int64      `json:"user_id"`
+	Username    string     `json:"username,omitempty"`
+	Status      string     `json:"status" enums:"draft,published,archived"`
+	PublishedAt *time.Time `json:"published_at,omitempty"` // In the future for a scheduled draft
+	Tags        []TagRef   `json:"tags"`
+	Categories  []TagRef   `json:"categories"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
 }
 
 // CreateBlogRequest represents blog creation request
 type CreateBlogRequest struct {
-	Title   string      `json:"title" validate:"required,min=1,max=255"`
-	Content string      `json:"content" validate:"required"`
-	UserID  interface{} `json:"user_id"` // Accept string or int
+	Title      string      `json:"title" validate:"required,min=1,max=255"`
+	Content    string      `json:"content" validate:"required"`
+	Status     string      `json:"status,omitempty" validate:"omitempty,oneof=draft published" enums:"draft,published"` // Defaults to draft
+	PublishAt  *time.Time  `json:"publish_at,omitempty"`                                                                // Schedules publishing, must be in the future
+	Tags       []string    `json:"tags,omitempty" validate:"dive,required,max=50"`                                      // Tag names, missing tags are created for admins and rejected otherwise
+	Categories []string    `json:"categories,omitempty" validate:"dive,slug"`                                           // Slugs of existing categories
+	UserID     interface{} `json:"user_id"`                                                                             // Admin only, defaults to the caller. Accept string or int
 }
 
 // UpdateBlogRequest represents blog update request
 type UpdateBlogRequest struct {
-	Title   *string `json:"title,omitempty" validate:"omitempty,min=1,max=255"`
-	Content *string `json:"content,omitempty"`
+	Title      *string  `json:"title,omitempty" validate:"omitempty,min=1,max=255"`
+	Content    *string  `json:"content,omitempty" validate:"omitempty,min=1"`
+	Status     *string  `json:"status,omitempty" validate:"omitempty,oneof=draft published archived" enums:"draft,published,archived"`
+	Tags       []string `json:"tags,omitempty" validate:"dive,required,max=50"` // Replaces the tags when present, missing tags are created for admins only
+	Categories []string `json:"categories,omitempty" validate:"dive,slug"`      // Replaces the categories when present
+}
+
+// PublishBlogRequest represents blog publish request. The body is optional.
+type PublishBlogRequest struct {
+	PublishAt *time.Time `json:"publish_at,omitempty"` // Schedules publishing, must be in the future. None publishes immediately
+}
+
+// BlogRevisionResponse represents a stored version of a blog
+type BlogRevisionResponse struct {
+	Revision       int       `json:"revision"`
+	Title          string    `json:"title"`
+	Content        string    `json:"content"`
+	EditorID       *int64    `json:"editor_id,omitempty"` // Omitted once the editor is deleted
+	EditorUsername string    `json:"editor_username,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// BlogRevisionDiffResponse compares a revision with the current blog
+type BlogRevisionDiffResponse struct {
+	Revision     int        `json:"revision"`
+	Title        string     `json:"title"`
+	CurrentTitle string     `json:"current_title"`
+	Added        int        `json:"added"`   // Lines only in the current content
+	Removed      int        `json:"removed"` // Lines only in the revision
+	Lines        []DiffLine `json:"lines"`
+}
+
+// DiffLine is one line of a content diff
+type DiffLine struct {
+	Op   string `json:"op" enums:"equal,insert,delete"`
+	Text string `json:"text"`
 }
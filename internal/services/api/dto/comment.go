@@ -2,22 +2,35 @@ package dto
 
 import "time"
 
-// CommentResponse represents comment data in API responses
+// CommentResponse represents comment data in API responses.
+// A tombstone is a deleted comment kept for its replies; its content and author are left out.
 type CommentResponse struct {
-	ID        int64     `json:"id"`
-	Content   string    `json:"content"`
-	BlogID    int64     `json:"blog_id"`
-	UserID    int64     `json:"user_id"`
-	Username  string    `json:"username,omitempty"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID         int64      `json:"id"`
+	Content    string     `json:"content"`
+	BlogID     int64      `json:"blog_id"`
+	UserID     int64      `json:"user_id,omitempty"`
+	Username   string     `json:"username,omitempty"`
+	ParentID   *int64     `json:"parent_id,omitempty"`
+	Depth      int        `json:"depth"`
+	ReplyCount int        `json:"reply_count"`
+	Tombstone  bool       `json:"tombstone,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+	DeletedAt  *time.Time `json:"deleted_at,omitempty"`
+}
+
+// CommentNode is a comment with its replies, oldest first
+type CommentNode struct {
+	CommentResponse
+	Replies []CommentNode `json:"replies"`
 }
 
 // CreateCommentRequest represents comment creation request
 type CreateCommentRequest struct {
-	Content string      `json:"content" validate:"required"`
-	BlogID  interface{} `json:"blog_id" validate:"required"` // Accept string or int
-	UserID  interface{} `json:"user_id" validate:"required"` // Accept string or int
+	Content  string      `json:"content" validate:"required"`
+	BlogID   interface{} `json:"blog_id" validate:"required"` // Accept string or int
+	ParentID interface{} `json:"parent_id"`                   // Comment replied to, on the same blog. Accept string or int
+	UserID   interface{} `json:"user_id"`                     // Admin only, defaults to the caller. Accept string or int
 }
 
 // UpdateCommentRequest represents comment update request
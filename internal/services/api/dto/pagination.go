@@ -2,18 +2,48 @@ package dto
 
 // PaginatedUserResponse represents paginated user list response
 type PaginatedUserResponse struct {
-	Data  []UserResponse `json:"data"`
-	Total int            `json:"total"`
+	Data       []UserResponse `json:"data"`
+	Total      *int           `json:"total,omitempty"` // Omitted when include_total=false
+	HasMore    bool           `json:"has_more"`
+	NextCursor string         `json:"next_cursor,omitempty"` // Set in cursor mode when has_more is true
 }
 
 // PaginatedBlogResponse represents paginated blog list response
 type PaginatedBlogResponse struct {
-	Data  []BlogResponse `json:"data"`
-	Total int            `json:"total"`
+	Data       []BlogResponse `json:"data"`
+	Total      *int           `json:"total,omitempty"` // Omitted when include_total=false
+	HasMore    bool           `json:"has_more"`
+	NextCursor string         `json:"next_cursor,omitempty"` // Set in cursor mode when has_more is true
 }
 
 // PaginatedCommentResponse represents paginated comment list response
 type PaginatedCommentResponse struct {
-	Data  []CommentResponse `json:"data"`
-	Total int               `json:"total"`
+	Data       []CommentResponse `json:"data"`
+	Total      *int              `json:"total,omitempty"` // Omitted when include_total=false
+	HasMore    bool              `json:"has_more"`
+	NextCursor string            `json:"next_cursor,omitempty"` // Set in cursor mode when has_more is true
+}
+
+// PaginatedAddressResponse represents paginated address list response
+type PaginatedAddressResponse struct {
+	Data       []AddressResponse `json:"data"`
+	Total      *int              `json:"total,omitempty"` // Omitted when include_total=false
+	HasMore    bool              `json:"has_more"`
+	NextCursor string            `json:"next_cursor,omitempty"` // Set in cursor mode when has_more is true
+}
+
+// PaginatedAuditLogResponse represents paginated audit log response
+type PaginatedAuditLogResponse struct {
+	Data       []AuditLogResponse `json:"data"`
+	Total      *int               `json:"total,omitempty"` // Omitted when include_total=false
+	HasMore    bool               `json:"has_more"`
+	NextCursor string             `json:"next_cursor,omitempty"` // Set in cursor mode when has_more is true
+}
+
+// PaginatedBlogRevisionResponse represents paginated blog revision list response
+type PaginatedBlogRevisionResponse struct {
+	Data       []BlogRevisionResponse `json:"data"`
+	Total      *int                   `json:"total,omitempty"` // Omitted when include_total=false
+	HasMore    bool                   `json:"has_more"`
+	NextCursor string                 `json:"next_cursor,omitempty"` // Set in cursor mode when has_more is true
 }
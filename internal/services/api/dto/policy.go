@@ -0,0 +1,27 @@
+package dto
+
+// PolicyRequest represents a permission rule: role may use method on path
+type PolicyRequest struct {
+	Role   string `json:"role" validate:"required,max=100"`
+	Path   string `json:"path" validate:"required,startswith=/,max=100"`
+	Method string `json:"method" validate:"required,oneof=GET POST PUT PATCH DELETE"`
+}
+
+// PolicyResponse represents a permission rule in API responses
+type PolicyResponse struct {
+	Role   string `json:"role"`
+	Path   string `json:"path"`
+	Method string `json:"method"`
+}
+
+// RoleAssignmentRequest grants subject all permissions of role
+type RoleAssignmentRequest struct {
+	Subject string `json:"subject" validate:"required,max=100"`
+	Role    string `json:"role" validate:"required,max=100,nefield=Subject"`
+}
+
+// RoleAssignmentResponse represents a role assignment in API responses
+type RoleAssignmentResponse struct {
+	Subject string `json:"subject"`
+	Role    string `json:"role"`
+}
@@ -0,0 +1,25 @@
+package dto
+
+import "time"
+
+// RoleResponse represents a role in API responses
+type RoleResponse struct {
+	ID          int64     `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	Parents     []string  `json:"parents"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// CreateRoleRequest represents role creation request. The role inherits all permissions of its parents.
+type CreateRoleRequest struct {
+	Name        string   `json:"name" validate:"required,max=50"`
+	Description string   `json:"description,omitempty" validate:"max=255"`
+	Parents     []string `json:"parents,omitempty" validate:"dive,required,max=50"`
+}
+
+// UpdateRoleRequest represents role update request. Parents replaces the current list when present.
+type UpdateRoleRequest struct {
+	Description *string  `json:"description,omitempty" validate:"omitempty,max=255"`
+	Parents     []string `json:"parents,omitempty" validate:"dive,required,max=50"`
+}
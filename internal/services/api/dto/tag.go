@@ -0,0 +1,60 @@
+package dto
+
+import "time"
+
+// TagRef names a tag or category a blog is filed under
+type TagRef struct {
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+// TagResponse represents a tag in API responses
+type TagResponse struct {
+	ID        int64     `json:"id"`
+	Name      string    `json:"name"`
+	Slug      string    `json:"slug"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TagCountResponse represents a tag with the number of blogs visible to the caller
+type TagCountResponse struct {
+	ID    int64  `json:"id"`
+	Name  string `json:"name"`
+	Slug  string `json:"slug"`
+	Count int    `json:"count"`
+}
+
+// CreateTagRequest represents tag creation request. The slug is generated from the name when omitted.
+type CreateTagRequest struct {
+	Name string `json:"name" validate:"required,max=50"`
+	Slug string `json:"slug,omitempty" validate:"omitempty,max=50,slug"`
+}
+
+// UpdateTagRequest represents tag update request. Renaming keeps the slug unless a new one is given.
+type UpdateTagRequest struct {
+	Name *string `json:"name,omitempty" validate:"omitempty,min=1,max=50"`
+	Slug *string `json:"slug,omitempty" validate:"omitempty,max=50,slug"`
+}
+
+// CategoryResponse represents a category in API responses
+type CategoryResponse struct {
+	ID          int64     `json:"id"`
+	Name        string    `json:"name"`
+	Slug        string    `json:"slug"`
+	Description string    `json:"description,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// CreateCategoryRequest represents category creation request. The slug is generated from the name when omitted.
+type CreateCategoryRequest struct {
+	Name        string `json:"name" validate:"required,max=100"`
+	Slug        string `json:"slug,omitempty" validate:"omitempty,max=100,slug"`
+	Description string `json:"description,omitempty" validate:"max=255"`
+}
+
+// UpdateCategoryRequest represents category update request. Renaming keeps the slug unless a new one is given.
+type UpdateCategoryRequest struct {
+	Name        *string `json:"name,omitempty" validate:"omitempty,min=1,max=100"`
+	Slug        *string `json:"slug,omitempty" validate:"omitempty,max=100,slug"`
+	Description *string `json:"description,omitempty" validate:"omitempty,max=255"`
+}
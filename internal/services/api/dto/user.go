@@ -4,26 +4,64 @@ import "time"
 
 // UserResponse represents user data in API responses
 type UserResponse struct {
-	ID        int64     `json:"id"`
-	Username  string    `json:"username"`
-	Email     string    `json:"email"`
-	Role      string    `json:"role"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID              int64      `json:"id"`
+	Username        string     `json:"username"`
+	Email           string     `json:"email"`
+	Roles           []string   `json:"roles"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+	EmailVerifiedAt *time.Time `json:"email_verified_at,omitempty"`
+	LockedUntil     *time.Time `json:"locked_until,omitempty"`
+	TwoFactor       bool       `json:"two_factor_enabled"`
+	DeletedAt       *time.Time `json:"deleted_at,omitempty"`
 }
 
 // CreateUserRequest represents user creation request
 type CreateUserRequest struct {
-	Username string `json:"username" validate:"required,min=3,max=50"`
-	Password string `json:"password" validate:"required,min=6"`
-	Email    string `json:"email" validate:"required,email"`
-	Role     string `json:"role" validate:"required,oneof=user admin"`
+	Username string   `json:"username" validate:"required,min=3,max=50"`
+	Password string   `json:"password" validate:"required,min=6"`
+	Email    string   `json:"email" validate:"required,email"`
+	Roles    []string `json:"roles" validate:"required,min=1,dive,required,max=50"`
 }
 
 // UpdateUserRequest represents user update request
 type UpdateUserRequest struct {
+	Username *string  `json:"username,omitempty" validate:"omitempty,min=3,max=50"`
+	Password *string  `json:"password,omitempty" validate:"omitempty,min=6"`
+	Email    *string  `json:"email,omitempty" validate:"omitempty,email"`
+	Roles    []string `json:"roles,omitempty" validate:"omitempty,min=1,dive,required,max=50"`
+}
+
+// UpdateProfileRequest represents a self-service profile update
+type UpdateProfileRequest struct {
 	Username *string `json:"username,omitempty" validate:"omitempty,min=3,max=50"`
-	Password *string `json:"password,omitempty" validate:"omitempty,min=6"`
 	Email    *string `json:"email,omitempty" validate:"omitempty,email"`
-	Role     *string `json:"role,omitempty" validate:"omitempty,oneof=user admin"`
+}
+
+// ChangePasswordRequest represents a self-service password change
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" validate:"required"`
+	NewPassword     string `json:"new_password" validate:"required,min=6"`
+}
+
+// TwoFactorSetupResponse holds a new TOTP secret to add to an authenticator app
+type TwoFactorSetupResponse struct {
+	Secret     string `json:"secret"`
+	OTPAuthURL string `json:"otpauth_url"`
+}
+
+// TwoFactorVerifyRequest confirms 2FA enrollment with a code from the app
+type TwoFactorVerifyRequest struct {
+	Code string `json:"code" validate:"required,len=6,numeric"`
+}
+
+// TwoFactorDisableRequest turns 2FA off. Code may be a TOTP or recovery code.
+type TwoFactorDisableRequest struct {
+	Password string `json:"password" validate:"required"`
+	Code     string `json:"code" validate:"required"`
+}
+
+// RecoveryCodesResponse lists one-time recovery codes. They are only shown once.
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
 }
@@ -0,0 +1,308 @@
+package handlers
+
+import (
+	"strconv"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/address"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/predicate"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/user"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/apierror"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/dto"
+	"github.com/gofiber/fiber/v2"
+)
+
+type AddressHandler struct {
+	client *ent.Client
+}
+
+func NewAddressHandler(client *ent.Client) *AddressHandler {
+	return &AddressHandler{client: client}
+}
+
+// GetAddresses returns list of addresses
+// @Security Bearer
+// @Summary Get all addresses
+// @Description List the addresses of all users (Admin only)
+// @Tags addresses
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number"
+// @Param limit query int false "Page size"
+// @Param cursor query string false "Keyset cursor from next_cursor; pass empty to start cursor mode"
+// @Param include_total query bool false "Include the total count (default true)"
+// @Success 200 {object} dto.PaginatedAddressResponse
+// @Failure 400 {object} dto.ErrorResponse "Invalid page parameters"
+// @Router /addresses [get]
+func (h *AddressHandler) GetAddresses(c *fiber.Ctx) error {
+	return h.listAddresses(c)
+}
+
+// GetUserAddresses returns the addresses of a single user
+// @Security Bearer
+// @Summary Get addresses of a user
+// @Description List the addresses of a user (Admin only)
+// @Tags addresses
+// @Accept json
+// @Produce json
+// @Param id path int true "User ID"
+// @Param page query int false "Page number"
+// @Param limit query int false "Page size"
+// @Param cursor query string false "Keyset cursor from next_cursor; pass empty to start cursor mode"
+// @Param include_total query bool false "Include the total count (default true)"
+// @Success 200 {object} dto.PaginatedAddressResponse
+// @Failure 400 {object} dto.ErrorResponse "Invalid page parameters"
+// @Failure 404 {object} dto.ErrorResponse "Not found"
+// @Router /users/{id}/addresses [get]
+func (h *AddressHandler) GetUserAddresses(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return apierror.ErrInvalidID
+	}
+
+	return h.listUserAddresses(c, id)
+}
+
+// GetMyAddresses returns the addresses of the authenticated user
+// @Security Bearer
+// @Summary Get my addresses
+// @Tags me
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number"
+// @Param limit query int false "Page size"
+// @Param cursor query string false "Keyset cursor from next_cursor; pass empty to start cursor mode"
+// @Param include_total query bool false "Include the total count (default true)"
+// @Success 200 {object} dto.PaginatedAddressResponse
+// @Failure 400 {object} dto.ErrorResponse "Invalid page parameters"
+// @Router /me/addresses [get]
+func (h *AddressHandler) GetMyAddresses(c *fiber.Ctx) error {
+	return h.listUserAddresses(c, int(currentUserID(c)))
+}
+
+// listUserAddresses returns a page of the addresses owned by the given user
+func (h *AddressHandler) listUserAddresses(c *fiber.Ctx, id int) error {
+	exists, err := h.client.User.Query().Where(user.ID(id)).Exist(c.UserContext())
+	if err != nil {
+		return apierror.FromEnt(err, nil)
+	}
+	if !exists {
+		return apierror.ErrUserNotFound
+	}
+
+	return h.listAddresses(c, address.HasUserWith(user.ID(id)))
+}
+
+// listAddresses returns a page of the addresses matching preds, newest first
+func (h *AddressHandler) listAddresses(c *fiber.Ctx, preds ...predicate.Address) error {
+	page, err := parsePageParams(c)
+	if err != nil {
+		return apierror.BadRequest(err.Error())
+	}
+
+	query := h.client.Address.Query().Where(preds...)
+
+	// Get total count (optional, it costs a full scan on large tables)
+	var total *int
+	if page.IncludeTotal {
+		n, err := query.Clone().Count(c.UserContext())
+		if err != nil {
+			return apierror.FromEnt(err, nil)
+		}
+		total = &n
+	}
+
+	if page.Cursor != nil {
+		query.Where(addressAfterCursor(page.Cursor))
+	}
+
+	addresses, err := query.
+		WithUser().
+		Order(address.ByCreatedAt(sql.OrderDesc()), address.ByID(sql.OrderDesc())).
+		Limit(page.Limit + 1).
+		Offset(page.Offset).
+		All(c.UserContext())
+	if err != nil {
+		return apierror.FromEnt(err, nil)
+	}
+
+	addresses, hasMore, nextCursor := trimPage(addresses, page, func(a *ent.Address) (time.Time, int) {
+		return a.CreatedAt, a.ID
+	})
+
+	data := make([]dto.AddressResponse, 0, len(addresses))
+	for _, a := range addresses {
+		data = append(data, toAddressResponse(a))
+	}
+
+	return c.JSON(dto.PaginatedAddressResponse{
+		Data:       data,
+		Total:      total,
+		HasMore:    hasMore,
+		NextCursor: nextCursor,
+	})
+}
+
+// CreateAddress creates a new address
+// @Security Bearer
+// @Summary Create address
+// @Tags addresses
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateAddressRequest true "Address details"
+// @Success 201 {object} dto.AddressResponse
+// @Failure 422 {object} dto.ErrorResponse "Validation failed"
+// @Router /addresses [post]
+func (h *AddressHandler) CreateAddress(c *fiber.Ctx) error {
+	var req dto.CreateAddressRequest
+	if err := bindBody(c, &req); err != nil {
+		return err
+	}
+
+	// If UserID is not provided in request, get it from JWT
+	userID := parseID(req.UserID)
+	if userID == 0 {
+		if uid, ok := c.Locals("user_id").(int64); ok {
+			userID = uid
+		}
+	}
+
+	// Verify user exists first
+	exists, err := h.client.User.Query().Where(user.ID(int(userID))).Exist(c.UserContext())
+	if err != nil || !exists {
+		return apierror.BadRequest("Invalid user ID")
+	}
+
+	a, err := h.client.Address.Create().
+		SetStreet(req.Street).
+		SetCity(req.City).
+		SetState(req.State).
+		SetZip(req.Zip).
+		SetUserID(int(userID)).
+		Save(c.UserContext())
+
+	if err != nil {
+		return apierror.FromEnt(err, nil)
+	}
+
+	// Re-query to get user info
+	a, _ = h.client.Address.Query().Where(address.ID(a.ID)).WithUser().Only(c.UserContext())
+
+	return c.Status(fiber.StatusCreated).JSON(toAddressResponse(a))
+}
+
+// GetAddress returns a single address
+// @Security Bearer
+// @Summary Get address by ID
+// @Description Get any user's address (Admin only)
+// @Tags addresses
+// @Accept json
+// @Produce json
+// @Param id path int true "Address ID"
+// @Success 200 {object} dto.AddressResponse
+// @Failure 404 {object} dto.ErrorResponse "Not found"
+// @Router /addresses/{id} [get]
+func (h *AddressHandler) GetAddress(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return apierror.ErrInvalidID
+	}
+
+	a, err := h.client.Address.Query().
+		Where(address.ID(id)).
+		WithUser().
+		Only(c.UserContext())
+
+	if err != nil {
+		return apierror.FromEnt(err, apierror.ErrAddressNotFound)
+	}
+
+	return c.JSON(toAddressResponse(a))
+}
+
+// UpdateAddress updates an address
+// @Security Bearer
+// @Summary Update address
+// @Tags addresses
+// @Accept json
+// @Produce json
+// @Param id path int true "Address ID"
+// @Param request body dto.UpdateAddressRequest true "Address details"
+// @Success 200 {object} dto.AddressResponse
+// @Failure 422 {object} dto.ErrorResponse "Validation failed"
+// @Failure 404 {object} dto.ErrorResponse "Not found"
+// @Router /addresses/{id} [patch]
+func (h *AddressHandler) UpdateAddress(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return apierror.ErrInvalidID
+	}
+
+	var req dto.UpdateAddressRequest
+	if err := bindBody(c, &req); err != nil {
+		return err
+	}
+
+	update := h.client.Address.UpdateOneID(id)
+	if req.Street != nil {
+		update.SetStreet(*req.Street)
+	}
+	if req.City != nil {
+		update.SetCity(*req.City)
+	}
+	if req.State != nil {
+		update.SetState(*req.State)
+	}
+	if req.Zip != nil {
+		update.SetZip(*req.Zip)
+	}
+
+	a, err := update.Save(c.UserContext())
+	if err != nil {
+		return apierror.FromEnt(err, apierror.ErrAddressNotFound)
+	}
+
+	// Re-query to get user info
+	a, _ = h.client.Address.Query().Where(address.ID(a.ID)).WithUser().Only(c.UserContext())
+
+	return c.JSON(toAddressResponse(a))
+}
+
+// DeleteAddress deletes an address
+// @Security Bearer
+// @Summary Delete address
+// @Tags addresses
+// @Param id path int true "Address ID"
+// @Success 204 "No Content"
+// @Failure 404 {object} dto.ErrorResponse "Not found"
+// @Router /addresses/{id} [delete]
+func (h *AddressHandler) DeleteAddress(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return apierror.ErrInvalidID
+	}
+
+	err = h.client.Address.DeleteOneID(id).Exec(c.UserContext())
+	if err != nil {
+		return apierror.FromEnt(err, apierror.ErrAddressNotFound)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// toAddressResponse maps an address (with its user edge loaded) to the API response
+func toAddressResponse(a *ent.Address) dto.AddressResponse {
+	return dto.AddressResponse{
+		ID:        int64(a.ID),
+		Street:    a.Street,
+		City:      a.City,
+		State:     a.State,
+		Zip:       a.Zip,
+		UserID:    int64(a.Edges.User.ID),
+		Username:  a.Edges.User.Username,
+		CreatedAt: a.CreatedAt,
+		UpdatedAt: a.UpdatedAt,
+	}
+}
@@ -0,0 +1,143 @@
+package handlers
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/apikey"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/user"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/apierror"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/dto"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/middleware"
+	"github.com/gofiber/fiber/v2"
+)
+
+// apiKeyPrefix marks API keys so they are recognisable in configs and secret scanners
+const apiKeyPrefix = "crud_"
+
+type APIKeyHandler struct {
+	client *ent.Client
+}
+
+func NewAPIKeyHandler(client *ent.Client) *APIKeyHandler {
+	return &APIKeyHandler{client: client}
+}
+
+// GetMyAPIKeys lists the API keys of the authenticated user
+// @Security Bearer
+// @Summary List my API keys
+// @Tags me
+// @Produce json
+// @Success 200 {array} dto.APIKeyResponse
+// @Router /me/api-keys [get]
+func (h *APIKeyHandler) GetMyAPIKeys(c *fiber.Ctx) error {
+	keys, err := h.client.APIKey.Query().
+		Where(apikey.HasUserWith(user.ID(int(currentUserID(c))))).
+		Order(ent.Desc(apikey.FieldCreatedAt)).
+		All(c.UserContext())
+	if err != nil {
+		return apierror.Internal(err)
+	}
+
+	resp := make([]dto.APIKeyResponse, 0, len(keys))
+	for _, k := range keys {
+		resp = append(resp, toAPIKeyResponse(k))
+	}
+
+	return c.JSON(resp)
+}
+
+// CreateAPIKey creates an API key for the authenticated user
+// @Security Bearer
+// @Summary Create API key
+// @Description Create a scoped API key, used as "Authorization: ApiKey <key>". The key acts with the owner's role and is only returned once. Scopes take the form <resource>:read (GET requests) or <resource>:write (all methods), where resource is the first path segment after /api/v1.
+// @Tags me
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateAPIKeyRequest true "API key details"
+// @Success 201 {object} dto.APIKeyCreatedResponse
+// @Failure 403 {object} dto.ErrorResponse "API keys cannot create API keys"
+// @Failure 422 {object} dto.ErrorResponse "Validation failed"
+// @Router /me/api-keys [post]
+func (h *APIKeyHandler) CreateAPIKey(c *fiber.Ctx) error {
+	// A leaked key must not be able to mint new ones
+	if c.Locals("api_key_id") != nil {
+		return apierror.Forbidden("API keys cannot be created with an API key")
+	}
+
+	var req dto.CreateAPIKeyRequest
+	if err := bindBody(c, &req); err != nil {
+		return err
+	}
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return apierror.Validation([]dto.FieldError{{
+			Field:   "expires_at",
+			Rule:    "future",
+			Message: "expires_at must be in the future",
+		}})
+	}
+
+	token, _, err := newOpaqueToken()
+	if err != nil {
+		return apierror.Internal(err)
+	}
+	key := apiKeyPrefix + token
+
+	k, err := h.client.APIKey.Create().
+		SetName(req.Name).
+		SetPrefix(key[:len(apiKeyPrefix)+8]).
+		SetKeyHash(middleware.HashAPIKey(key)).
+		SetScopes(req.Scopes).
+		SetNillableExpiresAt(req.ExpiresAt).
+		SetUserID(int(currentUserID(c))).
+		Save(c.UserContext())
+	if err != nil {
+		return apierror.FromEnt(err, nil)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(dto.APIKeyCreatedResponse{
+		APIKeyResponse: toAPIKeyResponse(k),
+		Key:            key,
+	})
+}
+
+// DeleteAPIKey revokes an API key of the authenticated user
+// @Security Bearer
+// @Summary Delete API key
+// @Tags me
+// @Param id path int true "API key ID"
+// @Success 204 "No Content"
+// @Failure 404 {object} dto.ErrorResponse "Not found"
+// @Router /me/api-keys/{id} [delete]
+func (h *APIKeyHandler) DeleteAPIKey(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return apierror.ErrInvalidID
+	}
+
+	n, err := h.client.APIKey.Delete().
+		Where(apikey.ID(id), apikey.HasUserWith(user.ID(int(currentUserID(c))))).
+		Exec(c.UserContext())
+	if err != nil {
+		return apierror.Internal(err)
+	}
+	if n == 0 {
+		return apierror.ErrAPIKeyNotFound
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// toAPIKeyResponse maps an API key to the API response
+func toAPIKeyResponse(k *ent.APIKey) dto.APIKeyResponse {
+	return dto.APIKeyResponse{
+		ID:         int64(k.ID),
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		Scopes:     k.Scopes,
+		LastUsedAt: k.LastUsedAt,
+		ExpiresAt:  k.ExpiresAt,
+		CreatedAt:  k.CreatedAt,
+	}
+}
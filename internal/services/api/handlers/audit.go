@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/auditlog"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/apierror"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/dto"
+	"github.com/gofiber/fiber/v2"
+)
+
+type AuditHandler struct {
+	client *ent.Client
+}
+
+func NewAuditHandler(client *ent.Client) *AuditHandler {
+	return &AuditHandler{client: client}
+}
+
+// GetAuditLogs returns the audit log
+// @Security Bearer
+// @Summary Get audit log
+// @Description List recorded creates, updates and deletes, newest first (Admin only)
+// @Tags audit
+// @Produce json
+// @Param page query int false "Page number"
+// @Param limit query int false "Page size"
+// @Param cursor query string false "Keyset cursor from next_cursor; pass empty to start cursor mode"
+// @Param include_total query bool false "Include the total count (default true)"
+// @Param actor_id query int false "Filter by acting user ID"
+// @Param entity_type query string false "Filter by entity type" Enums(User, Role, APIKey, CasbinRule, Blog, Comment, Address, Tag, Category)
+// @Param entity_id query int false "Filter by entity ID"
+// @Param operation query string false "Filter by operation" Enums(create, update, delete)
+// @Param request_id query string false "Filter by request ID (X-Request-ID)"
+// @Param created_after query string false "Created at or after (RFC 3339 or YYYY-MM-DD)"
+// @Param created_before query string false "Created before (RFC 3339 or YYYY-MM-DD)"
+// @Success 200 {object} dto.PaginatedAuditLogResponse
+// @Failure 400 {object} dto.ErrorResponse "Invalid filter"
+// @Router /audit [get]
+func (h *AuditHandler) GetAuditLogs(c *fiber.Ctx) error {
+	page, err := parsePageParams(c)
+	if err != nil {
+		return apierror.BadRequest(err.Error())
+	}
+
+	preds, err := auditListOptions(c)
+	if err != nil {
+		return apierror.BadRequest(err.Error())
+	}
+	query := h.client.AuditLog.Query().Where(preds...)
+
+	// Get total count (optional, it costs a full scan on large tables)
+	var total *int
+	if page.IncludeTotal {
+		n, err := query.Clone().Count(c.UserContext())
+		if err != nil {
+			return apierror.FromEnt(err, nil)
+		}
+		total = &n
+	}
+
+	if page.Cursor != nil {
+		query.Where(auditAfterCursor(page.Cursor))
+	}
+
+	entries, err := query.
+		Order(auditlog.ByCreatedAt(sql.OrderDesc()), auditlog.ByID(sql.OrderDesc())).
+		Limit(page.Limit + 1).
+		Offset(page.Offset).
+		All(c.UserContext())
+	if err != nil {
+		return apierror.FromEnt(err, nil)
+	}
+
+	entries, hasMore, nextCursor := trimPage(entries, page, func(row *ent.AuditLog) (time.Time, int) {
+		return row.CreatedAt, row.ID
+	})
+
+	data := make([]dto.AuditLogResponse, 0, len(entries))
+	for _, e := range entries {
+		data = append(data, toAuditLogResponse(e))
+	}
+
+	return c.JSON(dto.PaginatedAuditLogResponse{
+		Data:       data,
+		Total:      total,
+		HasMore:    hasMore,
+		NextCursor: nextCursor,
+	})
+}
+
+// toAuditLogResponse maps an audit log entry to the API response
+func toAuditLogResponse(e *ent.AuditLog) dto.AuditLogResponse {
+	changes := make(map[string]dto.AuditChange, len(e.Changes))
+	for name, ch := range e.Changes {
+		changes[name] = dto.AuditChange(ch)
+	}
+
+	resp := dto.AuditLogResponse{
+		ID:            int64(e.ID),
+		ActorUsername: e.ActorUsername,
+		IP:            e.IP,
+		RequestID:     e.RequestID,
+		EntityType:    e.EntityType,
+		EntityID:      int64(e.EntityID),
+		Operation:     e.Operation.String(),
+		Changes:       changes,
+		CreatedAt:     e.CreatedAt,
+	}
+	if e.ActorID != nil {
+		id := int64(*e.ActorID)
+		resp.ActorID = &id
+	}
+	if e.APIKeyID != nil {
+		id := int64(*e.APIKeyID)
+		resp.APIKeyID = &id
+	}
+	return resp
+}
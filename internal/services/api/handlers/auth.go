@@ -2,92 +2,590 @@ package handlers
 
 import (
 	"context"
+	"fmt"
+	"log"
+	"time"
 
 	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/passwordresettoken"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/refreshtoken"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/revokedtoken"
 	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/user"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/mailer"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/apierror"
 	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/dto"
 	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/middleware"
 	"github.com/gofiber/fiber/v2"
-	"golang.org/x/crypto/bcrypt"
 )
 
 type AuthHandler struct {
 	client *ent.Client
+	mail   mailer.Mailer
 }
 
-func NewAuthHandler(client *ent.Client) *AuthHandler {
-	return &AuthHandler{client: client}
+func NewAuthHandler(client *ent.Client, mail mailer.Mailer) *AuthHandler {
+	return &AuthHandler{client: client, mail: mail}
 }
 
 // Login handles user authentication
 // @Summary User login
-// @Description Authenticate user and return JWT token
+// @Description Authenticate user and return a short-lived JWT access token and a refresh token
 // @Tags auth
 // @Accept json
 // @Produce json
 // @Param request body dto.LoginRequest true "Login credentials"
 // @Success 200 {object} dto.LoginResponse
-// @Failure 401 {object} map[string]string "Invalid credentials"
-// @Failure 500 {object} map[string]string "Internal server error"
+// @Success 202 {object} dto.TwoFactorChallengeResponse "2FA code required, continue with /auth/2fa"
+// @Failure 422 {object} dto.ErrorResponse "Validation failed"
+// @Failure 401 {object} dto.ErrorResponse "Invalid credentials"
+// @Failure 403 {object} dto.ErrorResponse "Email not verified"
+// @Failure 423 {object} dto.ErrorResponse "Account locked, only returned for the correct password"
+// @Failure 429 {object} dto.ErrorResponse "Too many failed attempts from this IP"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
 // @Router /auth/login [post]
 func (h *AuthHandler) Login(c *fiber.Ctx) error {
 	var req dto.LoginRequest
-	if err := c.BodyParser(&req); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": "Invalid request body",
-		})
+	if err := bindBody(c, &req); err != nil {
+		return err
 	}
 
 	// Find user by username
 	u, err := h.client.User.Query().
 		Where(user.UsernameEQ(req.Username)).
-		Only(context.Background())
+		Only(c.UserContext())
 
 	if err != nil {
+		// Unknown usernames cost a bcrypt comparison too, so timing doesn't reveal them
 		if ent.IsNotFound(err) {
-			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-				"error": "Invalid credentials",
-			})
+			burnPasswordCheck(req.Password)
 		}
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"error": "Database error",
-		})
+		return apierror.FromEnt(err, apierror.ErrInvalidCredentials)
 	}
 
+	ctx := c.UserContext()
+
 	// Verify password
-	// Note: In a real app, you should use bcrypt.CompareHashAndPassword here.
-	// For this seeded data which might be plain text or hashed, we need to handle both
-	// or assume seeded data is hashed. Assuming seeded data is hashed with bcrypt.
-	// But our seeder might have used plain text.
-	// For now, let's implement standard bcrypt check but also fallback to plain text if check fails
-	// (only for development convenience with seeded simple passwords like 'admin123')
-	// TODO: Ensure seeder uses bcrypt and remove plain text fallback in production
-
-	err = bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(req.Password))
-	if err != nil {
-		// Fallback for simple seeded passwords (DEV ONLY)
-		if u.Password != req.Password {
-			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-				"error": "Invalid credentials",
-			})
+	ok, needsRehash := verifyPassword(u.Password, req.Password)
+
+	// A lock is only revealed to someone who knows the password, so it can't be
+	// used to probe for usernames. Wrong passwords don't extend it.
+	if u.LockedUntil != nil && time.Now().Before(*u.LockedUntil) {
+		if !ok {
+			return apierror.ErrInvalidCredentials
 		}
+		return accountLocked(*u.LockedUntil)
 	}
 
-	// Generate JWT token
-	token, err := middleware.GenerateToken(int64(u.ID), u.Username, u.Role.String())
-	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"error": "Failed to generate token",
+	if !ok {
+		return h.recordFailedLogin(ctx, u, apierror.ErrInvalidCredentials)
+	}
+
+	if u.EmailVerifiedAt == nil {
+		return apierror.ErrEmailNotVerified
+	}
+
+	// Upgrade outdated or plaintext stored passwords transparently
+	if needsRehash {
+		if hashed, err := hashPassword(req.Password); err == nil {
+			if err := u.Update().SetPassword(hashed).Exec(ctx); err != nil {
+				log.Printf("Failed to rehash password for user %d: %v", u.ID, err)
+			}
+		}
+	}
+
+	// Accounts with 2FA must complete a second step before getting tokens.
+	// Failed attempts are only reset then, so wrong codes count towards the lockout.
+	if u.TotpEnabledAt != nil {
+		challenge, err := newMFAChallenge(u.ID)
+		if err != nil {
+			return apierror.Internal(err)
+		}
+		return c.Status(fiber.StatusAccepted).JSON(dto.TwoFactorChallengeResponse{
+			MFARequired:    true,
+			ChallengeToken: challenge,
+			ExpiresIn:      int64(mfaChallengeTTL.Seconds()),
 		})
 	}
 
-	return c.JSON(dto.LoginResponse{
-		Token: token,
-		User: dto.UserSummary{
-			ID:       int64(u.ID),
-			Username: u.Username,
-			Email:    u.Email,
-			Role:     u.Role.String(),
-		},
-	})
+	if err := resetFailedLogins(ctx, u); err != nil {
+		return apierror.Internal(err)
+	}
+
+	// Generate access and refresh tokens
+	resp, err := issueTokens(ctx, h.client, u, "")
+	if err != nil {
+		return apierror.Internal(err)
+	}
+
+	return c.JSON(resp)
+}
+
+// LoginTwoFactor completes a login for an account with 2FA enabled
+// @Summary Complete 2FA login
+// @Description Exchange the challenge token returned by login and a TOTP or recovery code for a token pair. A challenge can be exchanged once; wrong codes count as failed logins towards the account lockout.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body dto.TwoFactorLoginRequest true "Challenge token and code"
+// @Success 200 {object} dto.LoginResponse
+// @Failure 401 {object} dto.ErrorResponse "Invalid challenge or code"
+// @Failure 422 {object} dto.ErrorResponse "Validation failed"
+// @Failure 423 {object} dto.ErrorResponse "Account locked"
+// @Failure 429 {object} dto.ErrorResponse "Too many failed attempts from this IP"
+// @Router /auth/2fa [post]
+func (h *AuthHandler) LoginTwoFactor(c *fiber.Ctx) error {
+	var req dto.TwoFactorLoginRequest
+	if err := bindBody(c, &req); err != nil {
+		return err
+	}
+
+	invalid := apierror.Unauthorized(apierror.CodeInvalid2FAToken, "Invalid or expired 2FA challenge")
+
+	id, claims, err := parseMFAChallenge(req.ChallengeToken)
+	if err != nil {
+		return invalid
+	}
+
+	ctx := c.UserContext()
+	used, err := h.client.RevokedToken.Query().Where(revokedtoken.Jti(claims.ID)).Exist(ctx)
+	if err != nil {
+		return apierror.Internal(err)
+	}
+	if used {
+		return invalid
+	}
+
+	u, err := h.client.User.Get(ctx, id)
+	if err != nil {
+		return apierror.FromEnt(err, invalid)
+	}
+	if u.TotpEnabledAt == nil {
+		return invalid
+	}
+	if u.LockedUntil != nil && time.Now().Before(*u.LockedUntil) {
+		return accountLocked(*u.LockedUntil)
+	}
+
+	ok, err := checkSecondFactor(ctx, h.client, u, req.Code)
+	if err != nil {
+		return apierror.Internal(err)
+	}
+	if !ok {
+		return h.recordFailedLogin(ctx, u, apierror.Unauthorized(apierror.CodeInvalid2FACode, "Invalid 2FA code"))
+	}
+
+	// Revoking the challenge's jti makes it single-use, also against concurrent exchanges
+	err = h.client.RevokedToken.Create().
+		SetJti(claims.ID).
+		SetExpiresAt(claims.ExpiresAt.Time).
+		Exec(ctx)
+	if ent.IsConstraintError(err) {
+		return invalid
+	}
+	if err != nil {
+		return apierror.Internal(err)
+	}
+
+	if err := resetFailedLogins(ctx, u); err != nil {
+		return apierror.Internal(err)
+	}
+
+	resp, err := issueTokens(ctx, h.client, u, "")
+	if err != nil {
+		return apierror.Internal(err)
+	}
+
+	return c.JSON(resp)
+}
+
+// recordFailedLogin counts a wrong password or 2FA code for u and locks the
+// account once the configured threshold is reached. Below it, invalid is returned.
+func (h *AuthHandler) recordFailedLogin(ctx context.Context, u *ent.User, invalid error) error {
+	u, err := h.client.User.UpdateOneID(u.ID).AddFailedLoginAttempts(1).Save(ctx)
+	if err != nil {
+		return apierror.Internal(err)
+	}
+
+	if u.FailedLoginAttempts < middleware.LockoutThreshold() {
+		return invalid
+	}
+
+	until := time.Now().Add(middleware.LockoutDuration())
+	err = h.client.User.UpdateOneID(u.ID).
+		SetFailedLoginAttempts(0).
+		SetLockedUntil(until).
+		Exec(ctx)
+	if err != nil {
+		return apierror.Internal(err)
+	}
+	log.Printf("Locked user %d until %s after repeated failed logins", u.ID, until.Format(time.RFC3339))
+
+	return accountLocked(until)
+}
+
+// resetFailedLogins clears the failed login counter and lockout of u after a successful login
+func resetFailedLogins(ctx context.Context, u *ent.User) error {
+	if u.FailedLoginAttempts == 0 && u.LockedUntil == nil {
+		return nil
+	}
+	return u.Update().SetFailedLoginAttempts(0).ClearLockedUntil().Exec(ctx)
+}
+
+// accountLocked returns the error for a login attempt on a locked account
+func accountLocked(until time.Time) error {
+	return apierror.New(fiber.StatusLocked, apierror.CodeAccountLocked,
+		fmt.Sprintf("Account is locked until %s", until.UTC().Format(time.RFC3339)))
+}
+
+// Register creates a new, unverified user account
+// @Summary Register
+// @Description Create a user account with the "user" role and email a verification link. The account cannot log in until the email is verified.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body dto.RegisterRequest true "Account details"
+// @Success 201 {object} dto.UserResponse
+// @Failure 409 {object} dto.ErrorResponse "Username or email already taken"
+// @Failure 422 {object} dto.ErrorResponse "Validation failed"
+// @Router /auth/register [post]
+func (h *AuthHandler) Register(c *fiber.Ctx) error {
+	var req dto.RegisterRequest
+	if err := bindBody(c, &req); err != nil {
+		return err
+	}
+
+	ctx := c.UserContext()
+	roles, err := findRoles(ctx, h.client, "roles", []string{defaultRole})
+	if err != nil {
+		return apierror.Internal(fmt.Errorf("default role %q is missing", defaultRole))
+	}
+
+	hashedPassword, err := hashPassword(req.Password)
+	if err != nil {
+		return apierror.Internal(err)
+	}
+
+	u, err := h.client.User.Create().
+		SetUsername(req.Username).
+		SetPassword(hashedPassword).
+		SetEmail(req.Email).
+		AddRoles(roles...).
+		Save(ctx)
+	if err != nil {
+		return apierror.FromEnt(err, nil)
+	}
+	u.Edges.Roles = roles
+
+	// The account exists either way; a failed send can be retried via the resend endpoint
+	if err := sendVerificationEmail(ctx, h.client, h.mail, u); err != nil {
+		log.Printf("Failed to send verification email to user %d: %v", u.ID, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(toUserResponse(u))
+}
+
+// VerifyEmail marks the email address of an account as verified
+// @Summary Verify email
+// @Description Consume the token sent by email on registration or email change
+// @Tags auth
+// @Param token query string true "Verification token"
+// @Success 204 "No Content"
+// @Failure 400 {object} dto.ErrorResponse "Invalid or expired token"
+// @Router /auth/verify-email [get]
+func (h *AuthHandler) VerifyEmail(c *fiber.Ctx) error {
+	invalid := apierror.New(fiber.StatusBadRequest, apierror.CodeInvalidVerifyToken, "Invalid or expired verification token")
+
+	id, email, err := parseVerificationToken(c.Query("token"))
+	if err != nil {
+		return invalid
+	}
+
+	// Only verify if the address is still the one the token was issued for
+	n, err := h.client.User.Update().
+		Where(user.ID(id), user.Email(email)).
+		SetEmailVerifiedAt(time.Now()).
+		Save(c.UserContext())
+	if err != nil {
+		return apierror.Internal(err)
+	}
+	if n == 0 {
+		return invalid
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// ResendVerification sends a new verification email
+// @Summary Resend verification email
+// @Description Always succeeds so it cannot be used to discover registered addresses. No email is sent if one went out within the last 5 minutes.
+// @Tags auth
+// @Accept json
+// @Param request body dto.ResendVerificationRequest true "Email address"
+// @Success 202 "Accepted"
+// @Failure 422 {object} dto.ErrorResponse "Validation failed"
+// @Failure 429 {object} dto.ErrorResponse "Too many requests from this IP"
+// @Router /auth/verify-email/resend [post]
+func (h *AuthHandler) ResendVerification(c *fiber.Ctx) error {
+	var req dto.ResendVerificationRequest
+	if err := bindBody(c, &req); err != nil {
+		return err
+	}
+
+	// Accounts mailed within the cooldown are skipped like unknown addresses
+	u, err := h.client.User.Query().
+		Where(
+			user.Email(req.Email),
+			user.EmailVerifiedAtIsNil(),
+			user.Or(user.VerificationSentAtIsNil(), user.VerificationSentAtLT(time.Now().Add(-emailResendCooldown))),
+		).
+		Only(c.UserContext())
+	if err != nil && !ent.IsNotFound(err) {
+		return apierror.Internal(err)
+	}
+	if u != nil {
+		if err := sendVerificationEmail(c.UserContext(), h.client, h.mail, u); err != nil {
+			log.Printf("Failed to send verification email to user %d: %v", u.ID, err)
+		}
+	}
+
+	return c.SendStatus(fiber.StatusAccepted)
+}
+
+// ForgotPassword emails a password reset link
+// @Summary Forgot password
+// @Description Email a single-use password reset link. Always succeeds so it cannot be used to discover registered addresses. No email is sent while a link issued within the last 5 minutes is still valid.
+// @Tags auth
+// @Accept json
+// @Param request body dto.ForgotPasswordRequest true "Email address"
+// @Success 202 "Accepted"
+// @Failure 422 {object} dto.ErrorResponse "Validation failed"
+// @Failure 429 {object} dto.ErrorResponse "Too many requests from this IP"
+// @Router /auth/password/forgot [post]
+func (h *AuthHandler) ForgotPassword(c *fiber.Ctx) error {
+	var req dto.ForgotPasswordRequest
+	if err := bindBody(c, &req); err != nil {
+		return err
+	}
+
+	ctx := c.UserContext()
+	u, err := h.client.User.Query().Where(user.Email(req.Email)).Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return apierror.Internal(err)
+	}
+	if u != nil {
+		if err := sendPasswordResetEmail(ctx, h.client, h.mail, u); err != nil {
+			log.Printf("Failed to send password reset email to user %d: %v", u.ID, err)
+		}
+	}
+
+	return c.SendStatus(fiber.StatusAccepted)
+}
+
+// ResetPassword sets a new password using a reset token
+// @Summary Reset password
+// @Description Consume a reset token and set a new password. All refresh tokens of the account are revoked.
+// @Tags auth
+// @Accept json
+// @Param request body dto.ResetPasswordRequest true "Reset token and new password"
+// @Success 204 "No Content"
+// @Failure 400 {object} dto.ErrorResponse "Invalid, expired or used token"
+// @Failure 422 {object} dto.ErrorResponse "Validation failed"
+// @Router /auth/password/reset [post]
+func (h *AuthHandler) ResetPassword(c *fiber.Ctx) error {
+	var req dto.ResetPasswordRequest
+	if err := bindBody(c, &req); err != nil {
+		return err
+	}
+
+	invalid := apierror.New(fiber.StatusBadRequest, apierror.CodeInvalidResetToken, "Invalid or expired reset token")
+
+	ctx := c.UserContext()
+	rt, err := h.client.PasswordResetToken.Query().
+		Where(
+			passwordresettoken.TokenHash(hashToken(req.Token)),
+			passwordresettoken.UsedAtIsNil(),
+			passwordresettoken.ExpiresAtGT(time.Now()),
+		).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		return apierror.FromEnt(err, invalid)
+	}
+
+	hashed, err := hashPassword(req.NewPassword)
+	if err != nil {
+		return apierror.Internal(err)
+	}
+
+	tx, err := h.client.Tx(ctx)
+	if err != nil {
+		return apierror.Internal(err)
+	}
+
+	// Conditional update so a token can only be consumed once
+	n, err := tx.PasswordResetToken.Update().
+		Where(passwordresettoken.ID(rt.ID), passwordresettoken.UsedAtIsNil()).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return apierror.Internal(err)
+	}
+	if n == 0 {
+		tx.Rollback()
+		return invalid
+	}
+
+	update := tx.User.UpdateOneID(rt.Edges.User.ID).SetPassword(hashed)
+	// Receiving the reset email proves ownership of the address
+	if rt.Edges.User.EmailVerifiedAt == nil {
+		update.SetEmailVerifiedAt(time.Now())
+	}
+	if err := update.Exec(ctx); err != nil {
+		tx.Rollback()
+		return apierror.FromEnt(err, apierror.ErrUserNotFound)
+	}
+
+	_, err = tx.RefreshToken.Update().
+		Where(refreshtoken.HasUserWith(user.ID(rt.Edges.User.ID)), refreshtoken.RevokedAtIsNil()).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return apierror.Internal(err)
+	}
+	if err := tx.Commit(); err != nil {
+		return apierror.Internal(err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// Refresh exchanges a refresh token for a new token pair
+// @Summary Refresh tokens
+// @Description Rotate a refresh token: the presented token is revoked and a new access/refresh pair is issued. Presenting an already rotated token revokes the whole session.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body dto.RefreshRequest true "Refresh token"
+// @Success 200 {object} dto.LoginResponse
+// @Failure 401 {object} dto.ErrorResponse "Invalid, expired or reused refresh token"
+// @Failure 422 {object} dto.ErrorResponse "Validation failed"
+// @Router /auth/refresh [post]
+func (h *AuthHandler) Refresh(c *fiber.Ctx) error {
+	var req dto.RefreshRequest
+	if err := bindBody(c, &req); err != nil {
+		return err
+	}
+
+	ctx := c.UserContext()
+	rt, err := h.client.RefreshToken.Query().
+		Where(refreshtoken.TokenHash(hashToken(req.RefreshToken))).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		return apierror.FromEnt(err, apierror.Unauthorized(apierror.CodeRefreshInvalid, "Invalid refresh token"))
+	}
+
+	// A revoked token being presented again means it was stolen or replayed:
+	// kill the whole session so neither party can keep using it.
+	if rt.RevokedAt != nil {
+		if err := revokeFamily(ctx, h.client, rt.Family); err != nil {
+			return apierror.Internal(err)
+		}
+		return apierror.Unauthorized(apierror.CodeRefreshReused, "Refresh token reuse detected, please log in again")
+	}
+	if time.Now().After(rt.ExpiresAt) {
+		return apierror.Unauthorized(apierror.CodeRefreshInvalid, "Refresh token expired")
+	}
+
+	tx, err := h.client.Tx(ctx)
+	if err != nil {
+		return apierror.Internal(err)
+	}
+
+	// Conditional update so two concurrent refreshes cannot both rotate the same token
+	n, err := tx.RefreshToken.Update().
+		Where(refreshtoken.ID(rt.ID), refreshtoken.RevokedAtIsNil()).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return apierror.Internal(err)
+	}
+	if n == 0 {
+		tx.Rollback()
+		if err := revokeFamily(ctx, h.client, rt.Family); err != nil {
+			return apierror.Internal(err)
+		}
+		return apierror.Unauthorized(apierror.CodeRefreshReused, "Refresh token reuse detected, please log in again")
+	}
+
+	resp, err := issueTokens(ctx, tx.Client(), rt.Edges.User, rt.Family)
+	if err != nil {
+		tx.Rollback()
+		return apierror.Internal(err)
+	}
+	if err := tx.Commit(); err != nil {
+		return apierror.Internal(err)
+	}
+
+	return c.JSON(resp)
+}
+
+// Logout revokes the current access token and, optionally, its refresh token session
+// @Security Bearer
+// @Summary User logout
+// @Description Revoke the access token used for this request. If a refresh token is given, its whole session is revoked too.
+// @Tags auth
+// @Accept json
+// @Param request body dto.LogoutRequest false "Refresh token to revoke"
+// @Success 204 "No Content"
+// @Failure 400 {object} dto.ErrorResponse "Called with an API key"
+// @Failure 401 {object} dto.ErrorResponse "Invalid or missing token"
+// @Router /auth/logout [post]
+func (h *AuthHandler) Logout(c *fiber.Ctx) error {
+	// API keys have no jti to revoke, they are revoked through /me/api-keys
+	if c.Locals("api_key_id") != nil {
+		return apierror.BadRequest("API keys cannot log out, revoke the key via DELETE /me/api-keys/{id} instead")
+	}
+
+	var req dto.LogoutRequest
+	if len(c.Body()) > 0 {
+		if err := bindBody(c, &req); err != nil {
+			return err
+		}
+	}
+
+	ctx := c.UserContext()
+
+	if req.RefreshToken != "" {
+		rt, err := h.client.RefreshToken.Query().
+			Where(
+				refreshtoken.TokenHash(hashToken(req.RefreshToken)),
+				refreshtoken.HasUserWith(user.ID(int(currentUserID(c)))),
+			).
+			Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return apierror.Internal(err)
+		}
+		if rt != nil {
+			if err := revokeFamily(ctx, h.client, rt.Family); err != nil {
+				return apierror.Internal(err)
+			}
+		}
+	}
+
+	jti, _ := c.Locals("jti").(string)
+	expiresAt, _ := c.Locals("token_expires_at").(time.Time)
+	err := h.client.RevokedToken.Create().
+		SetJti(jti).
+		SetExpiresAt(expiresAt).
+		Exec(ctx)
+	// A constraint error means the token was already revoked
+	if err != nil && !ent.IsConstraintError(err) {
+		return apierror.Internal(err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
 }
@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/apierror"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/dto"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/middleware"
+	"github.com/casbin/casbin/v2"
+	"github.com/gofiber/fiber/v2"
+)
+
+// capabilityOrder is the order capabilities are listed in
+var capabilityOrder = []string{"read", "create", "update", "delete"}
+
+type AuthzHandler struct {
+	enforcer *casbin.SyncedEnforcer
+}
+
+func NewAuthzHandler(enforcer *casbin.SyncedEnforcer) *AuthzHandler {
+	return &AuthzHandler{enforcer: enforcer}
+}
+
+// GetMyPermissions lists what the current token is allowed to do
+// @Security Bearer
+// @Summary Get my permissions
+// @Description Resolve the policies of all roles of the current token (including inherited roles and API key scopes) so clients can show or hide actions
+// @Tags me
+// @Produce json
+// @Success 200 {object} dto.PermissionsResponse
+// @Router /me/permissions [get]
+func (h *AuthzHandler) GetMyPermissions(c *fiber.Ctx) error {
+	seen := make(map[dto.Permission]bool)
+	var perms []dto.Permission
+
+	for _, role := range middleware.Roles(c) {
+		rules, err := h.enforcer.GetImplicitPermissionsForUser(role)
+		if err != nil {
+			return apierror.Internal(err)
+		}
+		for _, r := range rules {
+			p := dto.Permission{Path: r[1], Method: r[2]}
+			if seen[p] || !middleware.ScopeAllows(c, p.Method, p.Path) {
+				continue
+			}
+			seen[p] = true
+			perms = append(perms, p)
+		}
+	}
+
+	slices.SortFunc(perms, func(a, b dto.Permission) int {
+		if n := strings.Compare(a.Path, b.Path); n != 0 {
+			return n
+		}
+		return strings.Compare(a.Method, b.Method)
+	})
+
+	return c.JSON(dto.PermissionsResponse{
+		Roles:        middleware.Roles(c),
+		Permissions:  perms,
+		Capabilities: capabilities(perms),
+	})
+}
+
+// CheckPermissions answers a batch of permission checks
+// @Security Bearer
+// @Summary Check permissions
+// @Description Check whether the current token may make each request. Paths are concrete, e.g. /api/v1/blogs/12. Ownership rules are not evaluated.
+// @Tags authz
+// @Accept json
+// @Produce json
+// @Param request body dto.AuthzCheckRequest true "Requests to check"
+// @Success 200 {object} dto.AuthzCheckResponse
+// @Failure 422 {object} dto.ErrorResponse "Validation failed"
+// @Router /authz/check [post]
+func (h *AuthzHandler) CheckPermissions(c *fiber.Ctx) error {
+	var req dto.AuthzCheckRequest
+	if err := bindBody(c, &req); err != nil {
+		return err
+	}
+
+	results := make([]dto.AuthzCheckResult, 0, len(req.Checks))
+	for _, check := range req.Checks {
+		allowed := false
+		if middleware.ScopeAllows(c, check.Method, check.Path) {
+			var err error
+			if allowed, err = middleware.Allowed(c, check.Path, check.Method); err != nil {
+				return apierror.Internal(err)
+			}
+		}
+		results = append(results, dto.AuthzCheckResult{
+			Path:    check.Path,
+			Method:  check.Method,
+			Allowed: allowed,
+		})
+	}
+
+	return c.JSON(dto.AuthzCheckResponse{Results: results})
+}
+
+// capabilities groups permissions on /api/v1/<resource> and /api/v1/<resource>/:id by resource
+func capabilities(perms []dto.Permission) map[string][]string {
+	caps := make(map[string][]string)
+	for _, p := range perms {
+		parts := strings.Split(strings.TrimPrefix(p.Path, "/api/v1/"), "/")
+		if len(parts) > 2 || (len(parts) == 2 && !strings.HasPrefix(parts[1], ":")) {
+			continue
+		}
+
+		var capability string
+		switch p.Method {
+		case fiber.MethodGet:
+			capability = "read"
+		case fiber.MethodPost:
+			if len(parts) == 1 {
+				capability = "create"
+			}
+		case fiber.MethodPatch, fiber.MethodPut:
+			capability = "update"
+		case fiber.MethodDelete:
+			capability = "delete"
+		}
+		if capability != "" && !slices.Contains(caps[parts[0]], capability) {
+			caps[parts[0]] = append(caps[parts[0]], capability)
+		}
+	}
+
+	for resource := range caps {
+		slices.SortFunc(caps[resource], func(a, b string) int {
+			return slices.Index(capabilityOrder, a) - slices.Index(capabilityOrder, b)
+		})
+	}
+	return caps
+}
@@ -0,0 +1,217 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/category"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/apierror"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/dto"
+	"github.com/gofiber/fiber/v2"
+)
+
+type CategoryHandler struct {
+	client *ent.Client
+}
+
+func NewCategoryHandler(client *ent.Client) *CategoryHandler {
+	return &CategoryHandler{client: client}
+}
+
+// GetCategories returns all categories
+// @Security Bearer
+// @Summary List categories
+// @Tags categories
+// @Produce json
+// @Success 200 {array} dto.CategoryResponse
+// @Router /categories [get]
+func (h *CategoryHandler) GetCategories(c *fiber.Ctx) error {
+	categories, err := h.client.Category.Query().
+		Order(ent.Asc(category.FieldName)).
+		All(c.UserContext())
+	if err != nil {
+		return apierror.Internal(err)
+	}
+
+	resp := make([]dto.CategoryResponse, 0, len(categories))
+	for _, cat := range categories {
+		resp = append(resp, toCategoryResponse(cat))
+	}
+
+	return c.JSON(resp)
+}
+
+// CreateCategory creates a category
+// @Security Bearer
+// @Summary Create category
+// @Description Create a category blogs can be filed under. The slug is generated from the name unless given.
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateCategoryRequest true "Category details"
+// @Success 201 {object} dto.CategoryResponse
+// @Failure 409 {object} dto.ErrorResponse "Slug already exists"
+// @Failure 422 {object} dto.ErrorResponse "Validation failed"
+// @Router /categories [post]
+func (h *CategoryHandler) CreateCategory(c *fiber.Ctx) error {
+	var req dto.CreateCategoryRequest
+	if err := bindBody(c, &req); err != nil {
+		return err
+	}
+
+	s, err := makeSlug("slug", req.Slug, req.Name, categorySlugLen)
+	if err != nil {
+		return err
+	}
+
+	cat, err := h.client.Category.Create().
+		SetName(req.Name).
+		SetSlug(s).
+		SetDescription(req.Description).
+		Save(c.UserContext())
+	if err != nil {
+		return apierror.FromEnt(err, nil)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(toCategoryResponse(cat))
+}
+
+// GetCategory returns a single category
+// @Security Bearer
+// @Summary Get category by ID
+// @Tags categories
+// @Produce json
+// @Param id path int true "Category ID"
+// @Success 200 {object} dto.CategoryResponse
+// @Failure 404 {object} dto.ErrorResponse "Not found"
+// @Router /categories/{id} [get]
+func (h *CategoryHandler) GetCategory(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return apierror.ErrInvalidID
+	}
+
+	cat, err := h.client.Category.Get(c.UserContext(), id)
+	if err != nil {
+		return apierror.FromEnt(err, apierror.ErrCategoryNotFound)
+	}
+
+	return c.JSON(toCategoryResponse(cat))
+}
+
+// UpdateCategory updates a category
+// @Security Bearer
+// @Summary Update category
+// @Description Renaming a category keeps its slug, so links stay valid, unless a new slug is given.
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param id path int true "Category ID"
+// @Param request body dto.UpdateCategoryRequest true "Category details"
+// @Success 200 {object} dto.CategoryResponse
+// @Failure 404 {object} dto.ErrorResponse "Not found"
+// @Failure 409 {object} dto.ErrorResponse "Slug already exists"
+// @Failure 422 {object} dto.ErrorResponse "Validation failed"
+// @Router /categories/{id} [patch]
+func (h *CategoryHandler) UpdateCategory(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return apierror.ErrInvalidID
+	}
+
+	var req dto.UpdateCategoryRequest
+	if err := bindBody(c, &req); err != nil {
+		return err
+	}
+
+	update := h.client.Category.UpdateOneID(id)
+	if req.Name != nil {
+		update.SetName(*req.Name)
+	}
+	if req.Slug != nil {
+		update.SetSlug(*req.Slug)
+	}
+	if req.Description != nil {
+		update.SetDescription(*req.Description)
+	}
+
+	cat, err := update.Save(c.UserContext())
+	if err != nil {
+		return apierror.FromEnt(err, apierror.ErrCategoryNotFound)
+	}
+
+	return c.JSON(toCategoryResponse(cat))
+}
+
+// DeleteCategory deletes a category
+// @Security Bearer
+// @Summary Delete category
+// @Description Delete a category and remove it from all blogs.
+// @Tags categories
+// @Param id path int true "Category ID"
+// @Success 204 "No Content"
+// @Failure 404 {object} dto.ErrorResponse "Not found"
+// @Router /categories/{id} [delete]
+func (h *CategoryHandler) DeleteCategory(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return apierror.ErrInvalidID
+	}
+
+	if err := h.client.Category.DeleteOneID(id).Exec(c.UserContext()); err != nil {
+		return apierror.FromEnt(err, apierror.ErrCategoryNotFound)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// findCategories loads the categories with the given slugs, reporting unknown slugs as a validation error
+func findCategories(ctx context.Context, client *ent.Client, slugs []string) ([]*ent.Category, error) {
+	if len(slugs) == 0 {
+		return nil, nil
+	}
+
+	categories, err := client.Category.Query().Where(category.SlugIn(slugs...)).All(ctx)
+	if err != nil {
+		return nil, apierror.Internal(err)
+	}
+
+	for _, s := range slugs {
+		if !slices.ContainsFunc(categories, func(cat *ent.Category) bool { return cat.Slug == s }) {
+			return nil, apierror.Validation([]dto.FieldError{{
+				Field:   "categories",
+				Rule:    "exists",
+				Message: fmt.Sprintf("category %q does not exist", s),
+			}})
+		}
+	}
+	return categories, nil
+}
+
+// withCategories eager loads blog categories in name order
+func withCategories(q *ent.CategoryQuery) {
+	q.Order(ent.Asc(category.FieldName))
+}
+
+// toCategoryRefs maps categories to the references embedded in blog responses
+func toCategoryRefs(categories []*ent.Category) []dto.TagRef {
+	refs := make([]dto.TagRef, 0, len(categories))
+	for _, cat := range categories {
+		refs = append(refs, dto.TagRef{Name: cat.Name, Slug: cat.Slug})
+	}
+	return refs
+}
+
+// toCategoryResponse maps a category to the API response
+func toCategoryResponse(cat *ent.Category) dto.CategoryResponse {
+	return dto.CategoryResponse{
+		ID:          int64(cat.ID),
+		Name:        cat.Name,
+		Slug:        cat.Slug,
+		Description: cat.Description,
+		CreatedAt:   cat.CreatedAt,
+	}
+}
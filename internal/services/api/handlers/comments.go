@@ -1,14 +1,19 @@
 package handlers
 
 import (
-	"context"
+	"fmt"
 	"strconv"
+	"time"
 
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/config"
 	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent"
 	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/blog"
 	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/comment"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/predicate"
 	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/user"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/apierror"
 	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/dto"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/softdelete"
 	"github.com/gofiber/fiber/v2"
 )
 
@@ -23,104 +28,187 @@ func NewCommentHandler(client *ent.Client) *CommentHandler {
 // GetComments returns list of comments
 // @Security Bearer
 // @Summary Get all comments
+// @Description Users see the comments on blogs they can read, admins see all comments.
 // @Tags comments
 // @Accept json
 // @Produce json
 // @Param page query int false "Page number"
 // @Param limit query int false "Page size"
+// @Param cursor query string false "Keyset cursor from next_cursor; pass empty to start cursor mode (newest first)"
+// @Param include_total query bool false "Include the total count (default true)"
+// @Param sort query string false "Comma separated sort fields, prefix with - for descending (id, created_at, updated_at)"
+// @Param q query string false "Search in content"
+// @Param blog_id query int false "Filter by blog ID"
+// @Param author_id query int false "Filter by author ID"
+// @Param created_after query string false "Created at or after (RFC 3339 or YYYY-MM-DD)"
+// @Param created_before query string false "Created before (RFC 3339 or YYYY-MM-DD)"
+// @Param include_deleted query bool false "Include soft deleted comments (admin only)"
 // @Success 200 {object} dto.PaginatedCommentResponse
+// @Failure 400 {object} dto.ErrorResponse "Invalid filter or sort"
+// @Failure 403 {object} dto.ErrorResponse "include_deleted requires admin"
 // @Router /comments [get]
 func (h *CommentHandler) GetComments(c *fiber.Ctx) error {
-	page, _ := strconv.Atoi(c.Query("page", "1"))
-	limit, _ := strconv.Atoi(c.Query("limit", "10"))
-	offset := (page - 1) * limit
+	return h.listComments(c, commentVisibility(c)...)
+}
+
+// GetMyComments returns the comments written by the authenticated user
+// @Security Bearer
+// @Summary Get my comments
+// @Tags me
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number"
+// @Param limit query int false "Page size"
+// @Param cursor query string false "Keyset cursor from next_cursor; pass empty to start cursor mode (newest first)"
+// @Param include_total query bool false "Include the total count (default true)"
+// @Param sort query string false "Comma separated sort fields, prefix with - for descending (id, created_at, updated_at)"
+// @Param q query string false "Search in content"
+// @Param blog_id query int false "Filter by blog ID"
+// @Param created_after query string false "Created at or after (RFC 3339 or YYYY-MM-DD)"
+// @Param created_before query string false "Created before (RFC 3339 or YYYY-MM-DD)"
+// @Param include_deleted query bool false "Include soft deleted comments (admin only)"
+// @Success 200 {object} dto.PaginatedCommentResponse
+// @Failure 400 {object} dto.ErrorResponse "Invalid filter or sort"
+// @Failure 403 {object} dto.ErrorResponse "include_deleted requires admin"
+// @Router /me/comments [get]
+func (h *CommentHandler) GetMyComments(c *fiber.Ctx) error {
+	return h.listComments(c, comment.HasAuthorWith(user.ID(int(currentUserID(c)))))
+}
+
+// listComments runs a paginated, filtered comment list query restricted by scope
+func (h *CommentHandler) listComments(c *fiber.Ctx, scope ...predicate.Comment) error {
+	page, err := parsePageParams(c)
+	if err != nil {
+		return apierror.BadRequest(err.Error())
+	}
 
-	// Get total count
-	total, err := h.client.Comment.Query().Count(context.Background())
+	preds, order, err := commentListOptions(c, page)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+		return apierror.BadRequest(err.Error())
+	}
+
+	ctx, err := queryContext(c)
+	if err != nil {
+		return err
+	}
+	query := h.client.Comment.Query().Where(append(preds, scope...)...)
+
+	// Get total count (optional, it costs a full scan on large tables)
+	var total *int
+	if page.IncludeTotal {
+		n, err := query.Clone().Count(ctx)
+		if err != nil {
+			return apierror.FromEnt(err, nil)
+		}
+		total = &n
+	}
+
+	if page.Cursor != nil {
+		query.Where(commentAfterCursor(page.Cursor))
 	}
 
-	comments, err := h.client.Comment.Query().
+	comments, err := query.
 		WithAuthor().
 		WithBlog().
-		Limit(limit).
-		Offset(offset).
-		All(context.Background())
+		Order(order...).
+		Limit(page.Limit + 1).
+		Offset(page.Offset).
+		All(ctx)
 
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
-	}
-
-	var data []dto.CommentResponse
-	for _, cm := range comments {
-		resp := dto.CommentResponse{
-			ID:        int64(cm.ID),
-			Content:   cm.Content,
-			BlogID:    int64(cm.Edges.Blog.ID),
-			UserID:    int64(cm.Edges.Author.ID),
-			Username:  cm.Edges.Author.Username,
-			CreatedAt: cm.CreatedAt,
-			UpdatedAt: cm.UpdatedAt,
-		}
-		data = append(data, resp)
+		return apierror.FromEnt(err, nil)
+	}
+
+	comments, hasMore, nextCursor := trimPage(comments, page, func(row *ent.Comment) (time.Time, int) {
+		return row.CreatedAt, row.ID
+	})
+
+	data, err := commentResponses(ctx, h.client, comments)
+	if err != nil {
+		return apierror.Internal(err)
 	}
 
 	return c.JSON(dto.PaginatedCommentResponse{
-		Data:  data,
-		Total: total,
+		Data:       data,
+		Total:      total,
+		HasMore:    hasMore,
+		NextCursor: nextCursor,
 	})
 }
 
 // CreateComment creates a new comment
 // @Security Bearer
 // @Summary Create comment
+// @Description Create a comment as the authenticated user, or a reply with parent_id. Replies nest up to the configured depth. Only admins may set user_id to post for someone else.
 // @Tags comments
 // @Accept json
 // @Produce json
 // @Param request body dto.CreateCommentRequest true "Comment details"
 // @Success 201 {object} dto.CommentResponse
+// @Failure 422 {object} dto.ErrorResponse "Validation failed or replies nested too deep"
+// @Failure 403 {object} dto.ErrorResponse "user_id override requires admin"
+// @Failure 409 {object} dto.ErrorResponse "Parent comment is deleted"
 // @Router /comments [post]
 func (h *CommentHandler) CreateComment(c *fiber.Ctx) error {
 	var req dto.CreateCommentRequest
-	if err := c.BodyParser(&req); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid request: " + err.Error()})
-	}
-
-	// Validate required fields
-	if req.Content == "" {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Content is required"})
+	if err := bindBody(c, &req); err != nil {
+		return err
 	}
 
 	blogID := parseID(req.BlogID)
 	if blogID == 0 {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Blog ID is required"})
+		return apierror.BadRequest("Blog ID is required")
 	}
 
-	// If UserID is not provided in request, get it from JWT
-	userID := parseID(req.UserID)
-	if userID == 0 {
-		if uid, ok := c.Locals("user_id").(int64); ok {
-			userID = uid
+	// Default to the authenticated user; only admins may post on behalf of someone else
+	userID := currentUserID(c)
+	if requested := parseID(req.UserID); requested != 0 && requested != userID {
+		if !isAdmin(c) {
+			return apierror.Forbidden("Only admins can create comments for other users")
 		}
+		userID = requested
 	}
 
 	// Verify user and blog exist
-	userExists, _ := h.client.User.Query().Where(user.ID(int(userID))).Exist(context.Background())
-	blogExists, _ := h.client.Blog.Query().Where(blog.ID(int(blogID))).Exist(context.Background())
+	userExists, _ := h.client.User.Query().Where(user.ID(int(userID))).Exist(c.UserContext())
+	blogExists, _ := h.client.Blog.Query().Where(blog.ID(int(blogID))).Where(blogVisibility(c)...).Exist(c.UserContext())
 
 	if !userExists || !blogExists {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid user ID or blog ID"})
+		return apierror.BadRequest("Invalid user ID or blog ID")
 	}
 
-	cm, err := h.client.Comment.Create().
+	create := h.client.Comment.Create().
 		SetContent(req.Content).
 		SetAuthorID(int(userID)).
-		SetBlogID(int(blogID)).
-		Save(context.Background())
+		SetBlogID(int(blogID))
+
+	if parentID := parseID(req.ParentID); parentID != 0 {
+		parent, err := h.client.Comment.Query().
+			Where(comment.ID(int(parentID)), comment.HasBlogWith(blog.ID(int(blogID)))).
+			Only(c.UserContext())
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return apierror.BadRequest("Invalid parent ID, it must be a comment on the same blog")
+			}
+			return apierror.Internal(err)
+		}
+		if parent.TombstonedAt != nil {
+			return apierror.New(fiber.StatusConflict, apierror.CodeConflict, "Cannot reply to a deleted comment")
+		}
+		if maxDepth := config.AppConfig.API.CommentMaxDepth; parent.Depth >= maxDepth {
+			return apierror.Validation([]dto.FieldError{{
+				Field:   "parent_id",
+				Rule:    "max_depth",
+				Message: fmt.Sprintf("replies nest at most %d levels deep", maxDepth),
+			}})
+		}
+		create.SetParentID(parent.ID).SetDepth(parent.Depth + 1)
+	}
+
+	cm, err := create.Save(c.UserContext())
 
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+		return apierror.FromEnt(err, nil)
 	}
 
 	// Re-query to get edges
@@ -128,17 +216,9 @@ func (h *CommentHandler) CreateComment(c *fiber.Ctx) error {
 		Where(comment.ID(cm.ID)).
 		WithAuthor().
 		WithBlog().
-		Only(context.Background())
+		Only(c.UserContext())
 
-	return c.Status(fiber.StatusCreated).JSON(dto.CommentResponse{
-		ID:        int64(cm.ID),
-		Content:   cm.Content,
-		BlogID:    int64(cm.Edges.Blog.ID),
-		UserID:    int64(cm.Edges.Author.ID),
-		Username:  cm.Edges.Author.Username,
-		CreatedAt: cm.CreatedAt,
-		UpdatedAt: cm.UpdatedAt,
-	})
+	return c.Status(fiber.StatusCreated).JSON(toCommentResponse(cm))
 }
 
 // GetComment returns a single comment
@@ -148,70 +228,78 @@ func (h *CommentHandler) CreateComment(c *fiber.Ctx) error {
 // @Accept json
 // @Produce json
 // @Param id path int true "Comment ID"
+// @Param include_deleted query bool false "Also find a soft deleted comment (admin only)"
 // @Success 200 {object} dto.CommentResponse
+// @Failure 403 {object} dto.ErrorResponse "include_deleted requires admin"
+// @Failure 404 {object} dto.ErrorResponse "Not found"
 // @Router /comments/{id} [get]
 func (h *CommentHandler) GetComment(c *fiber.Ctx) error {
 	id, err := strconv.Atoi(c.Params("id"))
 	if err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid ID"})
+		return apierror.ErrInvalidID
+	}
+
+	ctx, err := queryContext(c)
+	if err != nil {
+		return err
 	}
 
 	cm, err := h.client.Comment.Query().
 		Where(comment.ID(id)).
+		Where(commentVisibility(c)...).
 		WithAuthor().
 		WithBlog().
-		Only(context.Background())
+		Only(ctx)
 
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "Comment not found"})
-		}
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+		return apierror.FromEnt(err, apierror.ErrCommentNotFound)
 	}
 
-	return c.JSON(dto.CommentResponse{
-		ID:        int64(cm.ID),
-		Content:   cm.Content,
-		BlogID:    int64(cm.Edges.Blog.ID),
-		UserID:    int64(cm.Edges.Author.ID),
-		Username:  cm.Edges.Author.Username,
-		CreatedAt: cm.CreatedAt,
-		UpdatedAt: cm.UpdatedAt,
-	})
+	resp, err := commentResponse(ctx, h.client, cm)
+	if err != nil {
+		return apierror.Internal(err)
+	}
+
+	return c.JSON(resp)
 }
 
 // UpdateComment updates a comment
 // @Security Bearer
 // @Summary Update comment
+// @Description Update a comment. Users may only update their own comments, admins may update any. Tombstones cannot be edited.
 // @Tags comments
 // @Accept json
 // @Produce json
 // @Param id path int true "Comment ID"
 // @Param request body dto.UpdateCommentRequest true "Comment details"
 // @Success 200 {object} dto.CommentResponse
+// @Failure 422 {object} dto.ErrorResponse "Validation failed"
+// @Failure 403 {object} dto.ErrorResponse "Not the owner"
+// @Failure 404 {object} dto.ErrorResponse "Not found"
 // @Router /comments/{id} [patch]
 func (h *CommentHandler) UpdateComment(c *fiber.Ctx) error {
 	id, err := strconv.Atoi(c.Params("id"))
 	if err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid ID"})
+		return apierror.ErrInvalidID
 	}
 
 	var req dto.UpdateCommentRequest
-	if err := c.BodyParser(&req); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid request"})
+	if err := bindBody(c, &req); err != nil {
+		return err
+	}
+
+	if err := h.checkOwner(c, id); err != nil {
+		return err
 	}
 
-	update := h.client.Comment.UpdateOneID(id)
+	update := h.client.Comment.UpdateOneID(id).Where(comment.TombstonedAtIsNil())
 	if req.Content != nil {
 		update.SetContent(*req.Content)
 	}
 
-	cm, err := update.Save(context.Background())
+	cm, err := update.Save(c.UserContext())
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "Comment not found"})
-		}
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+		return apierror.FromEnt(err, apierror.ErrCommentNotFound)
 	}
 
 	// Re-query to get edges
@@ -219,39 +307,158 @@ func (h *CommentHandler) UpdateComment(c *fiber.Ctx) error {
 		Where(comment.ID(cm.ID)).
 		WithAuthor().
 		WithBlog().
-		Only(context.Background())
+		Only(c.UserContext())
 
-	return c.JSON(dto.CommentResponse{
-		ID:        int64(cm.ID),
-		Content:   cm.Content,
-		BlogID:    int64(cm.Edges.Blog.ID),
-		UserID:    int64(cm.Edges.Author.ID),
-		Username:  cm.Edges.Author.Username,
-		CreatedAt: cm.CreatedAt,
-		UpdatedAt: cm.UpdatedAt,
-	})
+	resp, err := commentResponse(c.UserContext(), h.client, cm)
+	if err != nil {
+		return apierror.Internal(err)
+	}
+
+	return c.JSON(resp)
 }
 
 // DeleteComment deletes a comment
 // @Security Bearer
 // @Summary Delete comment
+// @Description Delete a comment. A comment with replies becomes a tombstone that keeps the thread together, otherwise it is removed, or with soft delete enabled marked deleted and restorable. Removing the last reply of a tombstone removes the tombstone too.
 // @Tags comments
 // @Param id path int true "Comment ID"
 // @Success 204 "No Content"
+// @Failure 403 {object} dto.ErrorResponse "Not the owner"
+// @Failure 404 {object} dto.ErrorResponse "Not found"
 // @Router /comments/{id} [delete]
 func (h *CommentHandler) DeleteComment(c *fiber.Ctx) error {
 	id, err := strconv.Atoi(c.Params("id"))
 	if err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid ID"})
+		return apierror.ErrInvalidID
 	}
 
-	err = h.client.Comment.DeleteOneID(id).Exec(context.Background())
-	if err != nil {
-		if ent.IsNotFound(err) {
-			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "Comment not found"})
-		}
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	if err := h.checkOwner(c, id); err != nil {
+		return err
+	}
+
+	if err := deleteComment(c.UserContext(), h.client, id); err != nil {
+		return apierror.FromEnt(err, apierror.ErrCommentNotFound)
 	}
 
 	return c.SendStatus(fiber.StatusNoContent)
 }
+
+// RestoreComment restores a soft deleted comment
+// @Security Bearer
+// @Summary Restore comment
+// @Description Restore a soft deleted comment. Users may only restore their own comments, admins may restore any.
+// @Tags comments
+// @Produce json
+// @Param id path int true "Comment ID"
+// @Success 200 {object} dto.CommentResponse
+// @Failure 403 {object} dto.ErrorResponse "Not the owner"
+// @Failure 404 {object} dto.ErrorResponse "Not found"
+// @Failure 409 {object} dto.ErrorResponse "Comment is not deleted or its blog, author or parent is deleted"
+// @Router /comments/{id}/restore [post]
+func (h *CommentHandler) RestoreComment(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return apierror.ErrInvalidID
+	}
+
+	ctx := c.UserContext()
+	all := softdelete.IncludeDeleted(ctx)
+	cm, err := h.client.Comment.Query().
+		Where(comment.ID(id)).
+		WithAuthor().
+		WithBlog().
+		WithParent().
+		Only(all)
+	if err != nil {
+		return apierror.FromEnt(err, apierror.ErrCommentNotFound)
+	}
+
+	if !canModify(c, commentAuthorID(cm)) {
+		return apierror.Forbidden("You can only modify your own comments")
+	}
+	if cm.DeletedAt == nil {
+		return apierror.New(fiber.StatusConflict, apierror.CodeConflict, "Comment is not deleted")
+	}
+	if cm.Edges.Blog.DeletedAt != nil || cm.Edges.Author == nil || cm.Edges.Author.DeletedAt != nil {
+		return apierror.New(fiber.StatusConflict, apierror.CodeConflict, "The blog or author of this comment is deleted, restore it first")
+	}
+	if cm.Edges.Parent != nil && cm.Edges.Parent.DeletedAt != nil {
+		return apierror.New(fiber.StatusConflict, apierror.CodeConflict, "The comment replied to is deleted, restore it first")
+	}
+
+	if err := h.client.Comment.UpdateOne(cm).ClearDeletedAt().Exec(all); err != nil {
+		return apierror.FromEnt(err, apierror.ErrCommentNotFound)
+	}
+
+	cm, err = h.client.Comment.Query().
+		Where(comment.ID(id)).
+		WithAuthor().
+		WithBlog().
+		Only(ctx)
+	if err != nil {
+		return apierror.FromEnt(err, apierror.ErrCommentNotFound)
+	}
+
+	resp, err := commentResponse(ctx, h.client, cm)
+	if err != nil {
+		return apierror.Internal(err)
+	}
+
+	return c.JSON(resp)
+}
+
+// checkOwner returns an error unless the authenticated user may modify the comment with the given ID.
+// Comments on blogs the user cannot read are reported as not found.
+func (h *CommentHandler) checkOwner(c *fiber.Ctx, id int) error {
+	cm, err := h.client.Comment.Query().
+		Where(comment.ID(id)).
+		Where(commentVisibility(c)...).
+		WithAuthor().
+		Only(c.UserContext())
+	if err != nil {
+		return apierror.FromEnt(err, apierror.ErrCommentNotFound)
+	}
+
+	if !canModify(c, commentAuthorID(cm)) {
+		return apierror.Forbidden("You can only modify your own comments")
+	}
+
+	return nil
+}
+
+// commentAuthorID returns the ID of the comment's author, 0 for a tombstone
+// whose author was hard deleted. The author edge must be loaded.
+func commentAuthorID(cm *ent.Comment) int {
+	if cm.Edges.Author == nil {
+		return 0
+	}
+	return cm.Edges.Author.ID
+}
+
+// toCommentResponse maps a comment to the API response without its reply count.
+// The author and blog edges must be loaded.
+func toCommentResponse(cm *ent.Comment) dto.CommentResponse {
+	resp := dto.CommentResponse{
+		ID:        int64(cm.ID),
+		Content:   cm.Content,
+		BlogID:    int64(cm.Edges.Blog.ID),
+		UserID:    int64(commentAuthorID(cm)),
+		Depth:     cm.Depth,
+		CreatedAt: cm.CreatedAt,
+		UpdatedAt: cm.UpdatedAt,
+		DeletedAt: cm.DeletedAt,
+	}
+	if cm.Edges.Author != nil {
+		resp.Username = cm.Edges.Author.Username
+	}
+	if cm.ParentID != nil {
+		id := int64(*cm.ParentID)
+		resp.ParentID = &id
+	}
+	if cm.TombstonedAt != nil {
+		resp.Content, resp.UserID, resp.Username = "", 0, ""
+		resp.Tombstone = true
+	}
+	return resp
+}
@@ -0,0 +1,271 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/config"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/address"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/blog"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/comment"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/predicate"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/user"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/apierror"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/softdelete"
+	"github.com/gofiber/fiber/v2"
+)
+
+// Deleting a user removes (or soft deletes) their addresses, blogs, comments
+// and the comments on their blogs; deleting a blog removes its comments. A
+// cascade runs in one transaction and soft deletes share one deleted_at, so
+// restoring the parent restores exactly the rows deleted with it. A user's
+// comments on other blogs that others replied to become tombstones instead,
+// like comments deleted with replies; hard deleting the user also clears
+// their authorship. Soft deleted replies are hidden from comment trees.
+
+// queryContext returns the context for read queries. Admins may pass
+// include_deleted=true to see soft deleted rows.
+func queryContext(c *fiber.Ctx) (context.Context, error) {
+	if !c.QueryBool("include_deleted") {
+		return c.UserContext(), nil
+	}
+	if !isAdmin(c) {
+		return nil, apierror.Forbidden("Only admins can include deleted records")
+	}
+	return softdelete.IncludeDeleted(c.UserContext()), nil
+}
+
+// withTx runs fn in a transaction, rolling back when it fails
+func withTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// userContent matches the comments written by the user or posted on their blogs
+func userContent(id int) predicate.Comment {
+	return comment.Or(
+		comment.HasAuthorWith(user.ID(id)),
+		comment.HasBlogWith(blog.HasAuthorWith(user.ID(id))),
+	)
+}
+
+// deleteUser deletes a user and everything they own
+func deleteUser(ctx context.Context, client *ent.Client, id int) error {
+	return withTx(ctx, client, func(tx *ent.Tx) error {
+		onBlogs := comment.HasBlogWith(blog.HasAuthorWith(user.ID(id)))
+		if config.AppConfig.API.SoftDelete {
+			now := time.Now()
+			if err := tx.Comment.Update().Where(onBlogs).SetDeletedAt(now).Exec(ctx); err != nil {
+				return fmt.Errorf("failed to delete comments: %w", err)
+			}
+			if err := deleteUserComments(ctx, tx, id, now); err != nil {
+				return err
+			}
+			if err := tx.Blog.Update().Where(blog.HasAuthorWith(user.ID(id))).SetDeletedAt(now).Exec(ctx); err != nil {
+				return fmt.Errorf("failed to delete blogs: %w", err)
+			}
+			return tx.User.UpdateOneID(id).SetDeletedAt(now).Exec(ctx)
+		}
+
+		// Soft deleted rows still hold their foreign keys
+		all := softdelete.IncludeDeleted(ctx)
+		if _, err := tx.Comment.Delete().Where(onBlogs).Exec(all); err != nil {
+			return fmt.Errorf("failed to delete comments: %w", err)
+		}
+		if err := deleteUserComments(all, tx, id, time.Now()); err != nil {
+			return err
+		}
+		if err := tx.Comment.Update().Where(comment.HasAuthorWith(user.ID(id))).ClearAuthor().Exec(all); err != nil {
+			return fmt.Errorf("failed to delete comments: %w", err)
+		}
+		if _, err := tx.Blog.Delete().Where(blog.HasAuthorWith(user.ID(id))).Exec(all); err != nil {
+			return fmt.Errorf("failed to delete blogs: %w", err)
+		}
+		if _, err := tx.Address.Delete().Where(address.HasUserWith(user.ID(id))).Exec(all); err != nil {
+			return fmt.Errorf("failed to delete addresses: %w", err)
+		}
+		return tx.User.DeleteOneID(id).Exec(ctx)
+	})
+}
+
+// deleteUserComments deletes the comments a user wrote on other blogs, leaves
+// first, and tombstones the ones left with replies by others at now. Hard
+// deletes count soft deleted replies as well since they still point at their
+// parent.
+func deleteUserComments(ctx context.Context, tx *ent.Tx, id int, now time.Time) error {
+	soft := config.AppConfig.API.SoftDelete
+	replies := comment.HasReplies()
+	if soft {
+		replies = comment.HasRepliesWith(comment.DeletedAtIsNil())
+	}
+
+	var parents []int
+	for {
+		leaves, err := tx.Comment.Query().
+			Where(comment.HasAuthorWith(user.ID(id)), comment.Not(replies)).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to look up replies: %w", err)
+		}
+		if len(leaves) == 0 {
+			break
+		}
+
+		ids := make([]int, 0, len(leaves))
+		for _, cm := range leaves {
+			ids = append(ids, cm.ID)
+			if cm.ParentID != nil {
+				parents = append(parents, *cm.ParentID)
+			}
+		}
+		if soft {
+			err = tx.Comment.Update().Where(comment.IDIn(ids...)).SetDeletedAt(now).Exec(ctx)
+		} else {
+			_, err = tx.Comment.Delete().Where(comment.IDIn(ids...)).Exec(ctx)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to delete comments: %w", err)
+		}
+	}
+
+	err := tx.Comment.Update().
+		Where(comment.HasAuthorWith(user.ID(id)), comment.TombstonedAtIsNil()).
+		SetTombstonedAt(now).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to tombstone comments: %w", err)
+	}
+
+	if soft {
+		return nil
+	}
+	return pruneTombstones(ctx, tx, parents)
+}
+
+// restoreUser restores a soft deleted user and the content deleted with them
+func restoreUser(ctx context.Context, client *ent.Client, u *ent.User) error {
+	all := softdelete.IncludeDeleted(ctx)
+	return withTx(all, client, func(tx *ent.Tx) error {
+		at := *u.DeletedAt
+		if err := tx.Comment.Update().Where(userContent(u.ID), comment.DeletedAt(at)).ClearDeletedAt().Exec(all); err != nil {
+			return fmt.Errorf("failed to restore comments: %w", err)
+		}
+		err := tx.Comment.Update().
+			Where(comment.HasAuthorWith(user.ID(u.ID)), comment.TombstonedAt(at)).
+			ClearTombstonedAt().
+			Exec(all)
+		if err != nil {
+			return fmt.Errorf("failed to restore comments: %w", err)
+		}
+		if err := tx.Blog.Update().Where(blog.HasAuthorWith(user.ID(u.ID)), blog.DeletedAt(at)).ClearDeletedAt().Exec(all); err != nil {
+			return fmt.Errorf("failed to restore blogs: %w", err)
+		}
+		return tx.User.UpdateOne(u).ClearDeletedAt().Exec(all)
+	})
+}
+
+// deleteBlog deletes a blog and its comments
+func deleteBlog(ctx context.Context, client *ent.Client, id int) error {
+	return withTx(ctx, client, func(tx *ent.Tx) error {
+		if config.AppConfig.API.SoftDelete {
+			now := time.Now()
+			if err := tx.Comment.Update().Where(comment.HasBlogWith(blog.ID(id))).SetDeletedAt(now).Exec(ctx); err != nil {
+				return fmt.Errorf("failed to delete comments: %w", err)
+			}
+			return tx.Blog.UpdateOneID(id).SetDeletedAt(now).Exec(ctx)
+		}
+
+		all := softdelete.IncludeDeleted(ctx)
+		if _, err := tx.Comment.Delete().Where(comment.HasBlogWith(blog.ID(id))).Exec(all); err != nil {
+			return fmt.Errorf("failed to delete comments: %w", err)
+		}
+		return tx.Blog.DeleteOneID(id).Exec(ctx)
+	})
+}
+
+// restoreBlog restores a soft deleted blog and the comments deleted with it
+func restoreBlog(ctx context.Context, client *ent.Client, b *ent.Blog) error {
+	all := softdelete.IncludeDeleted(ctx)
+	return withTx(all, client, func(tx *ent.Tx) error {
+		err := tx.Comment.Update().
+			Where(comment.HasBlogWith(blog.ID(b.ID)), comment.DeletedAt(*b.DeletedAt)).
+			ClearDeletedAt().
+			Exec(all)
+		if err != nil {
+			return fmt.Errorf("failed to restore comments: %w", err)
+		}
+		return tx.Blog.UpdateOne(b).ClearDeletedAt().Exec(all)
+	})
+}
+
+// deleteComment deletes a comment. A comment with replies becomes a tombstone
+// instead so its thread stays intact; removing the last reply of a tombstone
+// removes the tombstone as well.
+func deleteComment(ctx context.Context, client *ent.Client, id int) error {
+	return withTx(ctx, client, func(tx *ent.Tx) error {
+		cm, err := tx.Comment.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		hasReplies, err := tx.Comment.Query().Where(comment.ParentID(id)).Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to look up replies: %w", err)
+		}
+		if hasReplies {
+			if cm.TombstonedAt != nil {
+				return nil
+			}
+			return tx.Comment.UpdateOne(cm).SetTombstonedAt(time.Now()).Exec(ctx)
+		}
+
+		if config.AppConfig.API.SoftDelete {
+			// Tombstones stay so a restored reply finds its parent
+			return tx.Comment.UpdateOne(cm).SetDeletedAt(time.Now()).Exec(ctx)
+		}
+		if err := tx.Comment.DeleteOne(cm).Exec(ctx); err != nil {
+			return err
+		}
+		if cm.ParentID == nil {
+			return nil
+		}
+		return pruneTombstones(ctx, tx, []int{*cm.ParentID})
+	})
+}
+
+// pruneTombstones removes the tombstones among ids that have no replies left,
+// then their tombstoned parents in turn
+func pruneTombstones(ctx context.Context, tx *ent.Tx, ids []int) error {
+	for len(ids) > 0 {
+		empty, err := tx.Comment.Query().
+			Where(comment.IDIn(ids...), comment.TombstonedAtNotNil(), comment.Not(comment.HasReplies())).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to look up replies: %w", err)
+		}
+		if len(empty) == 0 {
+			return nil
+		}
+
+		ids = make([]int, 0, len(empty))
+		emptyIDs := make([]int, 0, len(empty))
+		for _, cm := range empty {
+			emptyIDs = append(emptyIDs, cm.ID)
+			if cm.ParentID != nil {
+				ids = append(ids, *cm.ParentID)
+			}
+		}
+		if _, err := tx.Comment.Delete().Where(comment.IDIn(emptyIDs...)).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete comments: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,284 @@
+package handlers
+
+import (
+	"log"
+	"time"
+
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/refreshtoken"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/user"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/mailer"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/apierror"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/dto"
+	"github.com/gofiber/fiber/v2"
+)
+
+type MeHandler struct {
+	client *ent.Client
+	mail   mailer.Mailer
+}
+
+func NewMeHandler(client *ent.Client, mail mailer.Mailer) *MeHandler {
+	return &MeHandler{client: client, mail: mail}
+}
+
+// GetMe returns the authenticated user's account
+// @Security Bearer
+// @Summary Get my account
+// @Tags me
+// @Produce json
+// @Success 200 {object} dto.UserResponse
+// @Router /me [get]
+func (h *MeHandler) GetMe(c *fiber.Ctx) error {
+	u, err := h.client.User.Query().
+		Where(user.ID(int(currentUserID(c)))).
+		WithRoles(withRoles).
+		Only(c.UserContext())
+	if err != nil {
+		return apierror.FromEnt(err, apierror.ErrUserNotFound)
+	}
+
+	return c.JSON(toUserResponse(u))
+}
+
+// UpdateMe updates the authenticated user's profile
+// @Security Bearer
+// @Summary Update my profile
+// @Description Update username and/or email. Roles and password cannot be changed here. Changing the email requires verifying the new address.
+// @Tags me
+// @Accept json
+// @Produce json
+// @Param request body dto.UpdateProfileRequest true "Profile details"
+// @Success 200 {object} dto.UserResponse
+// @Failure 409 {object} dto.ErrorResponse "Username or email already taken"
+// @Failure 422 {object} dto.ErrorResponse "Validation failed"
+// @Router /me [patch]
+func (h *MeHandler) UpdateMe(c *fiber.Ctx) error {
+	var req dto.UpdateProfileRequest
+	if err := bindBody(c, &req); err != nil {
+		return err
+	}
+
+	ctx := c.UserContext()
+	u, err := h.client.User.Get(ctx, int(currentUserID(c)))
+	if err != nil {
+		return apierror.FromEnt(err, apierror.ErrUserNotFound)
+	}
+
+	update := u.Update()
+	if req.Username != nil {
+		update.SetUsername(*req.Username)
+	}
+	emailChanged := req.Email != nil && *req.Email != u.Email
+	if emailChanged {
+		update.SetEmail(*req.Email).ClearEmailVerifiedAt()
+	}
+
+	u, err = update.Save(ctx)
+	if err != nil {
+		return apierror.FromEnt(err, apierror.ErrUserNotFound)
+	}
+
+	if emailChanged {
+		if err := sendVerificationEmail(ctx, h.client, h.mail, u); err != nil {
+			log.Printf("Failed to send verification email to user %d: %v", u.ID, err)
+		}
+	}
+	if err := loadRoles(ctx, u); err != nil {
+		return apierror.Internal(err)
+	}
+
+	return c.JSON(toUserResponse(u))
+}
+
+// ChangePassword changes the authenticated user's password
+// @Security Bearer
+// @Summary Change my password
+// @Description Requires the current password. All refresh tokens of the account are revoked.
+// @Tags me
+// @Accept json
+// @Param request body dto.ChangePasswordRequest true "Current and new password"
+// @Success 204 "No Content"
+// @Failure 401 {object} dto.ErrorResponse "Current password is incorrect"
+// @Failure 422 {object} dto.ErrorResponse "Validation failed"
+// @Router /me/password [post]
+func (h *MeHandler) ChangePassword(c *fiber.Ctx) error {
+	var req dto.ChangePasswordRequest
+	if err := bindBody(c, &req); err != nil {
+		return err
+	}
+
+	ctx := c.UserContext()
+	u, err := h.client.User.Get(ctx, int(currentUserID(c)))
+	if err != nil {
+		return apierror.FromEnt(err, apierror.ErrUserNotFound)
+	}
+
+	if ok, _ := verifyPassword(u.Password, req.CurrentPassword); !ok {
+		return apierror.Unauthorized(apierror.CodeInvalidCredentials, "Current password is incorrect")
+	}
+
+	hashed, err := hashPassword(req.NewPassword)
+	if err != nil {
+		return apierror.Internal(err)
+	}
+
+	tx, err := h.client.Tx(ctx)
+	if err != nil {
+		return apierror.Internal(err)
+	}
+	if err := tx.User.UpdateOneID(u.ID).SetPassword(hashed).Exec(ctx); err != nil {
+		tx.Rollback()
+		return apierror.FromEnt(err, apierror.ErrUserNotFound)
+	}
+
+	// Sign out every other session
+	_, err = tx.RefreshToken.Update().
+		Where(refreshtoken.HasUserWith(user.ID(u.ID)), refreshtoken.RevokedAtIsNil()).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return apierror.Internal(err)
+	}
+	if err := tx.Commit(); err != nil {
+		return apierror.Internal(err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// SetupTwoFactor starts TOTP enrollment
+// @Security Bearer
+// @Summary Start 2FA setup
+// @Description Generate a new TOTP secret. 2FA is only enabled once a code from the app is confirmed via /me/2fa/verify.
+// @Tags me
+// @Produce json
+// @Success 200 {object} dto.TwoFactorSetupResponse
+// @Failure 409 {object} dto.ErrorResponse "2FA already enabled"
+// @Router /me/2fa/setup [post]
+func (h *MeHandler) SetupTwoFactor(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	u, err := h.client.User.Get(ctx, int(currentUserID(c)))
+	if err != nil {
+		return apierror.FromEnt(err, apierror.ErrUserNotFound)
+	}
+	if u.TotpEnabledAt != nil {
+		return apierror.New(fiber.StatusConflict, apierror.Code2FAEnabled, "Two-factor authentication is already enabled")
+	}
+
+	secret, err := newTOTPSecret()
+	if err != nil {
+		return apierror.Internal(err)
+	}
+	if err := u.Update().SetTotpSecret(secret).Exec(ctx); err != nil {
+		return apierror.Internal(err)
+	}
+
+	return c.JSON(dto.TwoFactorSetupResponse{
+		Secret:     secret,
+		OTPAuthURL: totpURL(secret, u.Username),
+	})
+}
+
+// VerifyTwoFactor confirms TOTP enrollment and enables 2FA
+// @Security Bearer
+// @Summary Enable 2FA
+// @Description Confirm the secret from /me/2fa/setup with a current code. Returns one-time recovery codes that are not shown again.
+// @Tags me
+// @Accept json
+// @Produce json
+// @Param request body dto.TwoFactorVerifyRequest true "Code from the authenticator app"
+// @Success 200 {object} dto.RecoveryCodesResponse
+// @Failure 400 {object} dto.ErrorResponse "2FA setup not started"
+// @Failure 401 {object} dto.ErrorResponse "Invalid code"
+// @Failure 409 {object} dto.ErrorResponse "2FA already enabled"
+// @Failure 422 {object} dto.ErrorResponse "Validation failed"
+// @Router /me/2fa/verify [post]
+func (h *MeHandler) VerifyTwoFactor(c *fiber.Ctx) error {
+	var req dto.TwoFactorVerifyRequest
+	if err := bindBody(c, &req); err != nil {
+		return err
+	}
+
+	ctx := c.UserContext()
+	u, err := h.client.User.Get(ctx, int(currentUserID(c)))
+	if err != nil {
+		return apierror.FromEnt(err, apierror.ErrUserNotFound)
+	}
+	if u.TotpEnabledAt != nil {
+		return apierror.New(fiber.StatusConflict, apierror.Code2FAEnabled, "Two-factor authentication is already enabled")
+	}
+	if u.TotpSecret == "" {
+		return apierror.New(fiber.StatusBadRequest, apierror.Code2FANotEnabled, "Start 2FA setup first")
+	}
+	step, ok := validateTOTP(u.TotpSecret, req.Code, 0, time.Now())
+	if !ok {
+		return apierror.Unauthorized(apierror.CodeInvalid2FACode, "Invalid 2FA code")
+	}
+
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return apierror.Internal(err)
+	}
+	err = u.Update().
+		SetTotpEnabledAt(time.Now()).
+		SetTotpRecoveryCodes(hashes).
+		SetTotpLastStep(step).
+		Exec(ctx)
+	if err != nil {
+		return apierror.Internal(err)
+	}
+
+	return c.JSON(dto.RecoveryCodesResponse{RecoveryCodes: codes})
+}
+
+// DisableTwoFactor turns 2FA off
+// @Security Bearer
+// @Summary Disable 2FA
+// @Description Requires the password and a current TOTP or recovery code
+// @Tags me
+// @Accept json
+// @Param request body dto.TwoFactorDisableRequest true "Password and code"
+// @Success 204 "No Content"
+// @Failure 400 {object} dto.ErrorResponse "2FA not enabled"
+// @Failure 401 {object} dto.ErrorResponse "Invalid password or code"
+// @Failure 422 {object} dto.ErrorResponse "Validation failed"
+// @Router /me/2fa/disable [post]
+func (h *MeHandler) DisableTwoFactor(c *fiber.Ctx) error {
+	var req dto.TwoFactorDisableRequest
+	if err := bindBody(c, &req); err != nil {
+		return err
+	}
+
+	ctx := c.UserContext()
+	u, err := h.client.User.Get(ctx, int(currentUserID(c)))
+	if err != nil {
+		return apierror.FromEnt(err, apierror.ErrUserNotFound)
+	}
+	if u.TotpEnabledAt == nil {
+		return apierror.New(fiber.StatusBadRequest, apierror.Code2FANotEnabled, "Two-factor authentication is not enabled")
+	}
+	if ok, _ := verifyPassword(u.Password, req.Password); !ok {
+		return apierror.Unauthorized(apierror.CodeInvalidCredentials, "Password is incorrect")
+	}
+
+	ok, err := checkSecondFactor(ctx, h.client, u, req.Code)
+	if err != nil {
+		return apierror.Internal(err)
+	}
+	if !ok {
+		return apierror.Unauthorized(apierror.CodeInvalid2FACode, "Invalid 2FA code")
+	}
+
+	err = u.Update().
+		ClearTotpSecret().
+		ClearTotpEnabledAt().
+		ClearTotpRecoveryCodes().
+		Exec(ctx)
+	if err != nil {
+		return apierror.Internal(err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
@@ -0,0 +1,372 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/config"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/role"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/user"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/apierror"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/dto"
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/gofiber/fiber/v2"
+	"golang.org/x/oauth2"
+)
+
+const (
+	oidcStateCookie = "oidc_state"
+	oidcStateTTL    = 10 * time.Minute
+)
+
+// oidcClaims are the ID token claims used for provisioning
+type oidcClaims struct {
+	Subject           string `json:"sub"`
+	Email             string `json:"email"`
+	EmailVerified     bool   `json:"email_verified"`
+	PreferredUsername string `json:"preferred_username"`
+	Nonce             string `json:"nonce"`
+}
+
+type OIDCHandler struct {
+	client *ent.Client
+	cfg    config.OIDCConfig
+
+	// Discovery happens on first use so an unreachable provider does not stop the API from starting
+	mu       sync.Mutex
+	provider *oidc.Provider
+}
+
+func NewOIDCHandler(client *ent.Client, cfg config.OIDCConfig) *OIDCHandler {
+	return &OIDCHandler{client: client, cfg: cfg}
+}
+
+// setup returns the discovered provider and the OAuth2 client configuration
+func (h *OIDCHandler) setup(ctx context.Context) (*oidc.Provider, *oauth2.Config, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.provider == nil {
+		p, err := oidc.NewProvider(ctx, h.cfg.IssuerURL)
+		if err != nil {
+			return nil, nil, fmt.Errorf("oidc discovery failed: %w", err)
+		}
+		h.provider = p
+	}
+
+	scopes := h.cfg.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{oidc.ScopeOpenID, "profile", "email"}
+	}
+	return h.provider, &oauth2.Config{
+		ClientID:     h.cfg.ClientID,
+		ClientSecret: h.cfg.ClientSecret,
+		RedirectURL:  h.cfg.RedirectURL,
+		Endpoint:     h.provider.Endpoint(),
+		Scopes:       scopes,
+	}, nil
+}
+
+// Login redirects to the identity provider
+// @Summary OIDC login
+// @Description Start an authorization code flow (with PKCE) against the configured identity provider
+// @Tags auth
+// @Success 302 "Redirect to the identity provider"
+// @Failure 500 {object} dto.ErrorResponse "Provider unavailable"
+// @Router /auth/oidc/login [get]
+func (h *OIDCHandler) Login(c *fiber.Ctx) error {
+	_, oauth, err := h.setup(c.UserContext())
+	if err != nil {
+		return apierror.Internal(err)
+	}
+
+	state, _, err := newOpaqueToken()
+	if err != nil {
+		return apierror.Internal(err)
+	}
+	nonce, _, err := newOpaqueToken()
+	if err != nil {
+		return apierror.Internal(err)
+	}
+	verifier := oauth2.GenerateVerifier()
+
+	// The browser carries the flow state back to the callback
+	c.Cookie(&fiber.Cookie{
+		Name:     oidcStateCookie,
+		Value:    strings.Join([]string{state, nonce, verifier}, "."),
+		Path:     "/api/v1/auth/oidc",
+		Expires:  time.Now().Add(oidcStateTTL),
+		HTTPOnly: true,
+		Secure:   c.Protocol() == "https",
+		SameSite: fiber.CookieSameSiteLaxMode,
+	})
+
+	return c.Redirect(oauth.AuthCodeURL(state, oidc.Nonce(nonce), oauth2.S256ChallengeOption(verifier)), fiber.StatusFound)
+}
+
+// Callback completes the OIDC login and issues internal tokens
+// @Summary OIDC callback
+// @Description Exchange the authorization code, provision the user on first login and issue the usual token pair. Redirects to post_login_redirect with the tokens in the URL fragment when configured.
+// @Tags auth
+// @Produce json
+// @Param code query string true "Authorization code"
+// @Param state query string true "State"
+// @Success 200 {object} dto.LoginResponse
+// @Success 202 {object} dto.TwoFactorChallengeResponse "2FA code required, continue with /auth/2fa"
+// @Failure 400 {object} dto.ErrorResponse "Invalid state or provider error"
+// @Failure 401 {object} dto.ErrorResponse "ID token rejected"
+// @Failure 423 {object} dto.ErrorResponse "Account locked"
+// @Router /auth/oidc/callback [get]
+func (h *OIDCHandler) Callback(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	parts := strings.Split(c.Cookies(oidcStateCookie), ".")
+	c.Cookie(&fiber.Cookie{
+		Name:     oidcStateCookie,
+		Path:     "/api/v1/auth/oidc",
+		Expires:  time.Unix(0, 0),
+		HTTPOnly: true,
+	})
+	if len(parts) != 3 || c.Query("state") != parts[0] {
+		return apierror.BadRequest("Invalid or expired login state, please start again")
+	}
+	if e := c.Query("error"); e != "" {
+		return apierror.BadRequest(fmt.Sprintf("Identity provider returned an error: %s", e))
+	}
+
+	provider, oauth, err := h.setup(ctx)
+	if err != nil {
+		return apierror.Internal(err)
+	}
+
+	token, err := oauth.Exchange(ctx, c.Query("code"), oauth2.VerifierOption(parts[2]))
+	if err != nil {
+		return apierror.BadRequest("Failed to exchange authorization code")
+	}
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return apierror.BadRequest("Identity provider did not return an ID token")
+	}
+
+	idToken, err := provider.Verifier(&oidc.Config{ClientID: h.cfg.ClientID}).Verify(ctx, rawIDToken)
+	if err != nil {
+		return apierror.Unauthorized(apierror.CodeInvalidToken, "ID token rejected")
+	}
+	var claims oidcClaims
+	if err := idToken.Claims(&claims); err != nil || claims.Nonce != parts[1] {
+		return apierror.Unauthorized(apierror.CodeInvalidToken, "ID token rejected")
+	}
+	var raw map[string]interface{}
+	if err := idToken.Claims(&raw); err != nil {
+		return apierror.Internal(err)
+	}
+
+	u, err := h.provision(c.UserContext(), idToken.Issuer, claims, h.mapRoles(raw))
+	if err != nil {
+		return err
+	}
+
+	// Locked accounts are rejected here too, as on password login
+	if u.LockedUntil != nil && time.Now().Before(*u.LockedUntil) {
+		return accountLocked(*u.LockedUntil)
+	}
+
+	if u.TotpEnabledAt != nil {
+		challenge, err := newMFAChallenge(u.ID)
+		if err != nil {
+			return apierror.Internal(err)
+		}
+		resp := dto.TwoFactorChallengeResponse{
+			MFARequired:    true,
+			ChallengeToken: challenge,
+			ExpiresIn:      int64(mfaChallengeTTL.Seconds()),
+		}
+		if h.cfg.PostLoginRedirect != "" {
+			return h.redirectWith(c, url.Values{
+				"mfa_required":    {"true"},
+				"challenge_token": {resp.ChallengeToken},
+				"expires_in":      {fmt.Sprint(resp.ExpiresIn)},
+			})
+		}
+		return c.Status(fiber.StatusAccepted).JSON(resp)
+	}
+
+	resp, err := issueTokens(c.UserContext(), h.client, u, "")
+	if err != nil {
+		return apierror.Internal(err)
+	}
+	if h.cfg.PostLoginRedirect != "" {
+		return h.redirectWith(c, url.Values{
+			"access_token":  {resp.Token},
+			"refresh_token": {resp.RefreshToken},
+			"expires_in":    {fmt.Sprint(resp.ExpiresIn)},
+		})
+	}
+	return c.JSON(resp)
+}
+
+// redirectWith sends the browser to post_login_redirect with values in the fragment,
+// which is never sent to servers or written to access logs
+func (h *OIDCHandler) redirectWith(c *fiber.Ctx, values url.Values) error {
+	return c.Redirect(h.cfg.PostLoginRedirect+"#"+values.Encode(), fiber.StatusFound)
+}
+
+// mapRoles returns the local roles for the configured role claim, defaulting to "user".
+// The claim may be a string or a list of strings; values are matched case-insensitively.
+func (h *OIDCHandler) mapRoles(claims map[string]interface{}) []string {
+	if h.cfg.RoleClaim == "" {
+		return []string{defaultRole}
+	}
+
+	var values []string
+	switch v := claims[h.cfg.RoleClaim].(type) {
+	case string:
+		values = []string{v}
+	case []interface{}:
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+	}
+
+	var roles []string
+	for _, v := range values {
+		// Viper lower-cases map keys
+		if mapped, ok := h.cfg.RoleMapping[strings.ToLower(v)]; ok && !slices.Contains(roles, mapped) {
+			roles = append(roles, mapped)
+		}
+	}
+	if len(roles) == 0 {
+		return []string{defaultRole}
+	}
+	return roles
+}
+
+// localRoles loads the mapped roles, skipping names that do not exist locally
+// and falling back to the default role when none is left
+func (h *OIDCHandler) localRoles(ctx context.Context, names []string) ([]*ent.Role, error) {
+	roles, err := h.client.Role.Query().Where(role.NameIn(names...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(roles) != len(names) {
+		log.Printf("OIDC role mapping refers to unknown roles in %v", names)
+	}
+	if len(roles) == 0 {
+		return h.client.Role.Query().Where(role.Name(defaultRole)).All(ctx)
+	}
+	return roles, nil
+}
+
+// provision returns the local user for an external identity, linking an existing
+// account by verified email or creating one on first login. Roles are synced
+// from the provider on every login when a role claim is configured.
+func (h *OIDCHandler) provision(ctx context.Context, issuer string, claims oidcClaims, mapped []string) (*ent.User, error) {
+	roles, err := h.localRoles(ctx, mapped)
+	if err != nil {
+		return nil, apierror.Internal(err)
+	}
+
+	u, err := h.client.User.Query().
+		Where(user.OidcIssuer(issuer), user.OidcSubject(claims.Subject)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, apierror.Internal(err)
+	}
+
+	// Link a local account only when the provider vouches for the address
+	if u == nil && claims.Email != "" && claims.EmailVerified {
+		u, err = h.client.User.Query().
+			Where(user.Email(claims.Email), user.OidcSubjectIsNil()).
+			Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, apierror.Internal(err)
+		}
+	}
+
+	if u != nil {
+		update := u.Update().SetOidcIssuer(issuer).SetOidcSubject(claims.Subject)
+		if h.cfg.RoleClaim != "" {
+			update.ClearRoles().AddRoles(roles...)
+		}
+		if u.EmailVerifiedAt == nil && claims.EmailVerified && claims.Email == u.Email {
+			update.SetEmailVerifiedAt(time.Now())
+		}
+		u, err = update.Save(ctx)
+		if err != nil {
+			return nil, apierror.FromEnt(err, nil)
+		}
+		return u, nil
+	}
+
+	if claims.Email == "" {
+		return nil, apierror.BadRequest("Identity provider did not return an email address")
+	}
+
+	// SSO users have no usable local password
+	random, _, err := newOpaqueToken()
+	if err != nil {
+		return nil, apierror.Internal(err)
+	}
+	hashed, err := hashPassword(random)
+	if err != nil {
+		return nil, apierror.Internal(err)
+	}
+
+	username, err := h.freeUsername(ctx, claims)
+	if err != nil {
+		return nil, apierror.Internal(err)
+	}
+
+	create := h.client.User.Create().
+		SetUsername(username).
+		SetPassword(hashed).
+		SetEmail(claims.Email).
+		AddRoles(roles...).
+		SetOidcIssuer(issuer).
+		SetOidcSubject(claims.Subject)
+	if claims.EmailVerified {
+		create.SetEmailVerifiedAt(time.Now())
+	}
+	u, err = create.Save(ctx)
+	if err != nil {
+		return nil, apierror.FromEnt(err, nil)
+	}
+	log.Printf("Provisioned user %d (%s) from OIDC issuer %s", u.ID, u.Username, issuer)
+
+	return u, nil
+}
+
+// freeUsername picks an unused username based on the preferred username or email
+func (h *OIDCHandler) freeUsername(ctx context.Context, claims oidcClaims) (string, error) {
+	base := claims.PreferredUsername
+	if base == "" {
+		base, _, _ = strings.Cut(claims.Email, "@")
+	}
+	if len(base) > 40 {
+		base = base[:40]
+	}
+	for len(base) < 3 {
+		base += "_"
+	}
+
+	name := base
+	for i := 2; ; i++ {
+		taken, err := h.client.User.Query().Where(user.Username(name)).Exist(ctx)
+		if err != nil {
+			return "", err
+		}
+		if !taken {
+			return name, nil
+		}
+		name = fmt.Sprintf("%s%d", base, i)
+	}
+}
@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	defaultPageSize = 10
+	maxPageSize     = 100
+)
+
+// pageParams holds the pagination mode and window of a list request.
+// Offset mode uses page/limit; cursor mode is enabled by passing ?cursor=
+// (empty for the first page) and walks rows by (created_at, id) descending.
+type pageParams struct {
+	Limit        int
+	Offset       int
+	CursorMode   bool
+	Cursor       *pageCursor
+	IncludeTotal bool
+}
+
+// pageCursor is the keyset position of the last row returned
+type pageCursor struct {
+	CreatedAt time.Time `json:"t"`
+	ID        int       `json:"id"`
+}
+
+// parsePageParams parses page, limit, cursor and include_total
+func parsePageParams(c *fiber.Ctx) (pageParams, error) {
+	p := pageParams{IncludeTotal: true}
+
+	p.Limit, _ = strconv.Atoi(c.Query("limit", strconv.Itoa(defaultPageSize)))
+	if p.Limit < 1 {
+		p.Limit = defaultPageSize
+	}
+	if p.Limit > maxPageSize {
+		p.Limit = maxPageSize
+	}
+
+	if raw := c.Query("include_total"); raw != "" {
+		include, err := strconv.ParseBool(raw)
+		if err != nil {
+			return p, fmt.Errorf("invalid include_total: must be true or false")
+		}
+		p.IncludeTotal = include
+	}
+
+	if c.Context().QueryArgs().Has("cursor") {
+		p.CursorMode = true
+		if raw := c.Query("cursor"); raw != "" {
+			cur, err := decodeCursor(raw)
+			if err != nil {
+				return p, err
+			}
+			p.Cursor = cur
+		}
+		return p, nil
+	}
+
+	page, _ := strconv.Atoi(c.Query("page", "1"))
+	if page < 1 {
+		page = 1
+	}
+	p.Offset = (page - 1) * p.Limit
+
+	return p, nil
+}
+
+// encodeCursor returns the opaque cursor pointing after the given row
+func encodeCursor(createdAt time.Time, id int) string {
+	b, _ := json.Marshal(pageCursor{CreatedAt: createdAt, ID: id})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// decodeCursor parses a cursor produced by encodeCursor
+func decodeCursor(raw string) (*pageCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+	var cur pageCursor
+	if err := json.Unmarshal(b, &cur); err != nil || cur.ID == 0 {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+	return &cur, nil
+}
+
+// trimPage cuts the extra look-ahead row fetched with Limit+1 and reports
+// whether more rows exist. In cursor mode it also returns the next cursor.
+func trimPage[T any](rows []T, p pageParams, key func(T) (time.Time, int)) ([]T, bool, string) {
+	hasMore := len(rows) > p.Limit
+	if hasMore {
+		rows = rows[:p.Limit]
+	}
+
+	nextCursor := ""
+	if p.CursorMode && hasMore {
+		createdAt, id := key(rows[len(rows)-1])
+		nextCursor = encodeCursor(createdAt, id)
+	}
+
+	return rows, hasMore, nextCursor
+}
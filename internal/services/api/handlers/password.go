@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"sync"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// passwordCost is the bcrypt cost for new hashes. Stored hashes with a lower
+// cost are upgraded on the next successful login.
+const passwordCost = 12
+
+// hashPassword returns the bcrypt hash of a password
+func hashPassword(password string) (string, error) {
+	hashed, err := bcrypt.GenerateFromPassword([]byte(password), passwordCost)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash password: %w", err)
+	}
+	return string(hashed), nil
+}
+
+// verifyPassword checks a password against the stored value.
+// needsRehash is true when the password matched but the stored value should be
+// replaced: a bcrypt hash with an outdated cost, or a plaintext value accepted
+// by the dev-only fallback.
+func verifyPassword(stored, password string) (ok, needsRehash bool) {
+	cost, err := bcrypt.Cost([]byte(stored))
+	if err == nil {
+		if bcrypt.CompareHashAndPassword([]byte(stored), []byte(password)) != nil {
+			return false, false
+		}
+		return true, cost < passwordCost
+	}
+
+	// Not a bcrypt hash: only development builds accept plaintext values
+	if allowPlaintextPasswords && subtle.ConstantTimeCompare([]byte(stored), []byte(password)) == 1 {
+		return true, true
+	}
+
+	return false, false
+}
+
+// dummyHash is compared against when a login names an unknown user, so the
+// response takes as long as for a wrong password
+var dummyHash = sync.OnceValue(func() []byte {
+	hashed, _ := bcrypt.GenerateFromPassword([]byte("dummy password"), passwordCost)
+	return hashed
+})
+
+// burnPasswordCheck spends the time of a bcrypt comparison without a stored hash
+func burnPasswordCheck(password string) {
+	_ = bcrypt.CompareHashAndPassword(dummyHash(), []byte(password))
+}
@@ -0,0 +1,8 @@
+//go:build dev
+// +build dev
+
+package handlers
+
+// allowPlaintextPasswords lets development databases seeded with plaintext
+// passwords log in. Matching values are rehashed with bcrypt on login.
+const allowPlaintextPasswords = true
@@ -0,0 +1,7 @@
+//go:build !dev
+// +build !dev
+
+package handlers
+
+// allowPlaintextPasswords is always false outside development builds
+const allowPlaintextPasswords = false
@@ -0,0 +1,212 @@
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/apierror"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/dto"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/middleware"
+	"github.com/casbin/casbin/v2"
+	"github.com/gofiber/fiber/v2"
+)
+
+// policyAdminPath is checked after removals so an admin cannot lock everyone out of policy management
+const policyAdminPath = "/api/v1/admin/policies"
+
+type PolicyHandler struct {
+	enforcer *casbin.SyncedEnforcer
+}
+
+func NewPolicyHandler(enforcer *casbin.SyncedEnforcer) *PolicyHandler {
+	return &PolicyHandler{enforcer: enforcer}
+}
+
+// GetPolicies lists permission rules
+// @Security Bearer
+// @Summary List policies
+// @Tags policies
+// @Produce json
+// @Param role query string false "Filter by role"
+// @Success 200 {array} dto.PolicyResponse
+// @Router /admin/policies [get]
+func (h *PolicyHandler) GetPolicies(c *fiber.Ctx) error {
+	rules, err := h.enforcer.GetFilteredPolicy(0, c.Query("role"))
+	if err != nil {
+		return apierror.Internal(err)
+	}
+
+	resp := make([]dto.PolicyResponse, 0, len(rules))
+	for _, r := range rules {
+		resp = append(resp, dto.PolicyResponse{Role: r[0], Path: r[1], Method: r[2]})
+	}
+
+	return c.JSON(resp)
+}
+
+// CreatePolicy adds a permission rule
+// @Security Bearer
+// @Summary Add policy
+// @Description Allow a role to call method on path. Paths may use keyMatch2 patterns such as /api/v1/blogs/:id. Takes effect immediately.
+// @Tags policies
+// @Accept json
+// @Produce json
+// @Param request body dto.PolicyRequest true "Policy"
+// @Success 201 {object} dto.PolicyResponse
+// @Failure 409 {object} dto.ErrorResponse "Policy already exists"
+// @Failure 422 {object} dto.ErrorResponse "Validation failed"
+// @Router /admin/policies [post]
+func (h *PolicyHandler) CreatePolicy(c *fiber.Ctx) error {
+	var req dto.PolicyRequest
+	if err := bindBody(c, &req); err != nil {
+		return err
+	}
+
+	added, err := h.enforcer.AddPolicy(req.Role, req.Path, req.Method)
+	if err != nil {
+		return apierror.Internal(err)
+	}
+	if !added {
+		return apierror.ErrPolicyExists
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(dto.PolicyResponse(req))
+}
+
+// DeletePolicy removes a permission rule
+// @Security Bearer
+// @Summary Remove policy
+// @Tags policies
+// @Param role query string true "Role"
+// @Param path query string true "Path"
+// @Param method query string true "HTTP method"
+// @Success 204 "No Content"
+// @Failure 404 {object} dto.ErrorResponse "Policy not found"
+// @Failure 409 {object} dto.ErrorResponse "Removal would lock the caller out of policy management"
+// @Router /admin/policies [delete]
+func (h *PolicyHandler) DeletePolicy(c *fiber.Ctx) error {
+	rule := []interface{}{c.Query("role"), c.Query("path"), c.Query("method")}
+
+	removed, err := h.enforcer.RemovePolicy(rule...)
+	if err != nil {
+		return apierror.Internal(err)
+	}
+	if !removed {
+		return apierror.ErrPolicyNotFound
+	}
+
+	if err := h.keepAdminAccess(c, func() (bool, error) { return h.enforcer.AddPolicy(rule...) }); err != nil {
+		return err
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// GetRoleAssignments lists role inheritance rules
+// @Security Bearer
+// @Summary List role assignments
+// @Tags policies
+// @Produce json
+// @Success 200 {array} dto.RoleAssignmentResponse
+// @Router /admin/role-assignments [get]
+func (h *PolicyHandler) GetRoleAssignments(c *fiber.Ctx) error {
+	rules, err := h.enforcer.GetGroupingPolicy()
+	if err != nil {
+		return apierror.Internal(err)
+	}
+
+	resp := make([]dto.RoleAssignmentResponse, 0, len(rules))
+	for _, r := range rules {
+		resp = append(resp, dto.RoleAssignmentResponse{Subject: r[0], Role: r[1]})
+	}
+
+	return c.JSON(resp)
+}
+
+// CreateRoleAssignment adds a role inheritance rule
+// @Security Bearer
+// @Summary Add role assignment
+// @Description Grant subject every permission of role (a Casbin "g" rule). Takes effect immediately.
+// @Tags policies
+// @Accept json
+// @Produce json
+// @Param request body dto.RoleAssignmentRequest true "Role assignment"
+// @Success 201 {object} dto.RoleAssignmentResponse
+// @Failure 409 {object} dto.ErrorResponse "Role assignment already exists"
+// @Failure 422 {object} dto.ErrorResponse "Validation failed"
+// @Router /admin/role-assignments [post]
+func (h *PolicyHandler) CreateRoleAssignment(c *fiber.Ctx) error {
+	var req dto.RoleAssignmentRequest
+	if err := bindBody(c, &req); err != nil {
+		return err
+	}
+
+	added, err := h.enforcer.AddGroupingPolicy(req.Subject, req.Role)
+	if err != nil {
+		return apierror.Internal(err)
+	}
+	if !added {
+		return apierror.ErrPolicyExists
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(dto.RoleAssignmentResponse(req))
+}
+
+// DeleteRoleAssignment removes a role inheritance rule
+// @Security Bearer
+// @Summary Remove role assignment
+// @Tags policies
+// @Param subject query string true "Subject"
+// @Param role query string true "Role"
+// @Success 204 "No Content"
+// @Failure 404 {object} dto.ErrorResponse "Role assignment not found"
+// @Failure 409 {object} dto.ErrorResponse "Removal would lock the caller out of policy management"
+// @Router /admin/role-assignments [delete]
+func (h *PolicyHandler) DeleteRoleAssignment(c *fiber.Ctx) error {
+	rule := []interface{}{c.Query("subject"), c.Query("role")}
+
+	removed, err := h.enforcer.RemoveGroupingPolicy(rule...)
+	if err != nil {
+		return apierror.Internal(err)
+	}
+	if !removed {
+		return apierror.ErrPolicyNotFound
+	}
+
+	if err := h.keepAdminAccess(c, func() (bool, error) { return h.enforcer.AddGroupingPolicy(rule...) }); err != nil {
+		return err
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// ReloadPolicies reloads all rules from the database
+// @Security Bearer
+// @Summary Reload policies
+// @Description Re-read the rules from the database, e.g. after editing casbin_rules directly. Instances also reload every minute.
+// @Tags policies
+// @Success 204 "No Content"
+// @Router /admin/policies/reload [post]
+func (h *PolicyHandler) ReloadPolicies(c *fiber.Ctx) error {
+	if err := h.enforcer.LoadPolicy(); err != nil {
+		return apierror.Internal(fmt.Errorf("failed to reload casbin policy: %w", err))
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// keepAdminAccess restores a removed rule when none of the caller's roles can manage policies anymore
+func (h *PolicyHandler) keepAdminAccess(c *fiber.Ctx, restore func() (bool, error)) error {
+	for _, role := range middleware.Roles(c) {
+		allowed, err := h.enforcer.Enforce(role, policyAdminPath, fiber.MethodDelete)
+		if err != nil {
+			return apierror.Internal(err)
+		}
+		if allowed {
+			return nil
+		}
+	}
+
+	if _, err := restore(); err != nil {
+		return apierror.Internal(err)
+	}
+	return apierror.New(fiber.StatusConflict, apierror.CodeConflict, "Removing this rule would revoke your own access to policy management")
+}
@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/blog"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/comment"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/predicate"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/user"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/apierror"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/dto"
+	"github.com/gofiber/fiber/v2"
+)
+
+// PublishBlog publishes a blog now or at a later time
+// @Security Bearer
+// @Summary Publish blog
+// @Description Publish a draft or archived blog. With publish_at, which must be in the future, the blog stays a draft and is published by the scheduler at that time. Users may only publish their own blogs, admins may publish any.
+// @Tags blogs
+// @Accept json
+// @Produce json
+// @Param id path int true "Blog ID"
+// @Param request body dto.PublishBlogRequest false "Publish time"
+// @Success 200 {object} dto.BlogResponse
+// @Failure 403 {object} dto.ErrorResponse "Not the owner"
+// @Failure 404 {object} dto.ErrorResponse "Not found"
+// @Failure 422 {object} dto.ErrorResponse "publish_at is in the past"
+// @Router /blogs/{id}/publish [post]
+func (h *BlogHandler) PublishBlog(c *fiber.Ctx) error {
+	var req dto.PublishBlogRequest
+	if len(c.Body()) > 0 {
+		if err := bindBody(c, &req); err != nil {
+			return err
+		}
+	}
+	return h.changeStatus(c, blog.StatusPublished, req.PublishAt)
+}
+
+// UnpublishBlog turns a blog back into a draft
+// @Security Bearer
+// @Summary Unpublish blog
+// @Description Turn a published, scheduled or archived blog back into a draft, hiding it from other users. Users may only unpublish their own blogs, admins may unpublish any.
+// @Tags blogs
+// @Produce json
+// @Param id path int true "Blog ID"
+// @Success 200 {object} dto.BlogResponse
+// @Failure 403 {object} dto.ErrorResponse "Not the owner"
+// @Failure 404 {object} dto.ErrorResponse "Not found"
+// @Router /blogs/{id}/unpublish [post]
+func (h *BlogHandler) UnpublishBlog(c *fiber.Ctx) error {
+	return h.changeStatus(c, blog.StatusDraft, nil)
+}
+
+// changeStatus moves the blog of the id parameter to status and returns it
+func (h *BlogHandler) changeStatus(c *fiber.Ctx, status blog.Status, publishAt *time.Time) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return apierror.ErrInvalidID
+	}
+
+	if err := h.checkOwner(c, id); err != nil {
+		return err
+	}
+
+	ctx := c.UserContext()
+	err = withTx(ctx, h.client, func(tx *ent.Tx) error {
+		return setStatus(ctx, tx, id, status, publishAt)
+	})
+	if err != nil {
+		return apierror.FromEnt(err, apierror.ErrBlogNotFound)
+	}
+
+	b, err := h.client.Blog.Query().
+		Where(blog.ID(id)).
+		WithAuthor().
+		WithTags(withTags).
+		WithCategories(withCategories).
+		Only(ctx)
+	if err != nil {
+		return apierror.FromEnt(err, apierror.ErrBlogNotFound)
+	}
+
+	return c.JSON(toBlogResponse(b))
+}
+
+// setStatus moves a blog to status. Publishing at a future publishAt schedules
+// it instead: the blog stays a draft with published_at set until the scheduler
+// publishes it. A publishAt in the past is rejected rather than backdating the
+// blog, so published_at always records when a blog actually went public.
+// Publishing a published blog keeps its original publish time.
+func setStatus(ctx context.Context, tx *ent.Tx, id int, status blog.Status, publishAt *time.Time) error {
+	now := time.Now()
+	if publishAt != nil && !publishAt.After(now) {
+		return apierror.Validation([]dto.FieldError{{
+			Field:   "publish_at",
+			Rule:    "future",
+			Message: "publish_at must be in the future, leave it out to publish now",
+		}})
+	}
+
+	b, err := tx.Blog.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	update := tx.Blog.UpdateOne(b)
+	switch status {
+	case blog.StatusPublished:
+		if publishAt != nil {
+			update.SetStatus(blog.StatusDraft).SetPublishedAt(*publishAt)
+		} else if b.Status != blog.StatusPublished {
+			update.SetStatus(blog.StatusPublished).SetPublishedAt(now)
+		}
+	case blog.StatusDraft:
+		update.SetStatus(blog.StatusDraft).ClearPublishedAt()
+	default:
+		update.SetStatus(status)
+	}
+	return update.Exec(ctx)
+}
+
+// blogVisibility restricts blogs to those the caller may read: admins see
+// every blog, other users published blogs and their own
+func blogVisibility(c *fiber.Ctx) []predicate.Blog {
+	if isAdmin(c) {
+		return nil
+	}
+	return []predicate.Blog{
+		blog.Or(
+			blog.StatusEQ(blog.StatusPublished),
+			blog.HasAuthorWith(user.ID(int(currentUserID(c)))),
+		),
+	}
+}
+
+// commentVisibility restricts comments to those on blogs the caller may read
+func commentVisibility(c *fiber.Ctx) []predicate.Comment {
+	if isAdmin(c) {
+		return nil
+	}
+	return []predicate.Comment{comment.HasBlogWith(blogVisibility(c)...)}
+}
@@ -0,0 +1,368 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/address"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/auditlog"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/blog"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/blogrevision"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/category"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/comment"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/predicate"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/role"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/tag"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/user"
+	"github.com/gofiber/fiber/v2"
+)
+
+// Sortable columns per resource, keyed by the name accepted in ?sort=
+var (
+	userSortFields = map[string]string{
+		"id":         user.FieldID,
+		"username":   user.FieldUsername,
+		"email":      user.FieldEmail,
+		"created_at": user.FieldCreatedAt,
+		"updated_at": user.FieldUpdatedAt,
+	}
+	blogSortFields = map[string]string{
+		"id":           blog.FieldID,
+		"title":        blog.FieldTitle,
+		"published_at": blog.FieldPublishedAt,
+		"created_at":   blog.FieldCreatedAt,
+		"updated_at":   blog.FieldUpdatedAt,
+	}
+	commentSortFields = map[string]string{
+		"id":         comment.FieldID,
+		"created_at": comment.FieldCreatedAt,
+		"updated_at": comment.FieldUpdatedAt,
+	}
+)
+
+// errSortWithCursor is returned when sort= is combined with cursor pagination,
+// which always orders by (created_at, id) descending
+var errSortWithCursor = errors.New("sort is not supported with cursor pagination")
+
+// parseSort parses a sort expression such as "-created_at,title" into SQL order terms.
+// Only columns listed in allowed are accepted; a leading "-" sorts descending.
+// The ID is always appended as a tie-breaker so pages are stable.
+func parseSort(raw string, allowed map[string]string) ([]func(*sql.Selector), error) {
+	var orders []func(*sql.Selector)
+	hasID := false
+
+	for _, part := range strings.Split(raw, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		desc := strings.HasPrefix(part, "-")
+		name := strings.TrimPrefix(part, "-")
+		column, ok := allowed[name]
+		if !ok {
+			return nil, fmt.Errorf("cannot sort by %q", name)
+		}
+
+		var opts []sql.OrderTermOption
+		if desc {
+			opts = append(opts, sql.OrderDesc())
+		}
+		orders = append(orders, sql.OrderByField(column, opts...).ToFunc())
+
+		if column == "id" {
+			hasID = true
+		}
+	}
+
+	if !hasID {
+		orders = append(orders, sql.OrderByField("id").ToFunc())
+	}
+
+	return orders, nil
+}
+
+// queryInt parses an integer filter. ok is false when the parameter is absent.
+func queryInt(c *fiber.Ctx, key string) (value int, ok bool, err error) {
+	raw := c.Query(key)
+	if raw == "" {
+		return 0, false, nil
+	}
+	value, err = strconv.Atoi(raw)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid %s: must be an integer", key)
+	}
+	return value, true, nil
+}
+
+// queryList parses a comma separated filter, skipping empty items
+func queryList(c *fiber.Ctx, key string) []string {
+	var values []string
+	for _, v := range strings.Split(c.Query(key), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// queryTime parses a time filter given as RFC 3339 or a plain date (2006-01-02).
+// ok is false when the parameter is absent.
+func queryTime(c *fiber.Ctx, key string) (value time.Time, ok bool, err error) {
+	raw := c.Query(key)
+	if raw == "" {
+		return time.Time{}, false, nil
+	}
+	for _, layout := range []string{time.RFC3339, "2006-01-02"} {
+		if value, err = time.Parse(layout, raw); err == nil {
+			return value, true, nil
+		}
+	}
+	return time.Time{}, false, fmt.Errorf("invalid %s: use RFC 3339 or YYYY-MM-DD", key)
+}
+
+// userListOptions builds the filters and ordering for GET /users.
+// Supported: role, created_after, created_before, q (username/email) and sort.
+func userListOptions(c *fiber.Ctx, page pageParams) ([]predicate.User, []user.OrderOption, error) {
+	var preds []predicate.User
+
+	if name := c.Query("role"); name != "" {
+		preds = append(preds, user.HasRolesWith(role.Name(name)))
+	}
+	if t, ok, err := queryTime(c, "created_after"); err != nil {
+		return nil, nil, err
+	} else if ok {
+		preds = append(preds, user.CreatedAtGTE(t))
+	}
+	if t, ok, err := queryTime(c, "created_before"); err != nil {
+		return nil, nil, err
+	} else if ok {
+		preds = append(preds, user.CreatedAtLT(t))
+	}
+	if q := c.Query("q"); q != "" {
+		preds = append(preds, user.Or(user.UsernameContains(q), user.EmailContains(q)))
+	}
+
+	if page.CursorMode {
+		if c.Query("sort") != "" {
+			return nil, nil, errSortWithCursor
+		}
+		return preds, []user.OrderOption{
+			user.ByCreatedAt(sql.OrderDesc()),
+			user.ByID(sql.OrderDesc()),
+		}, nil
+	}
+
+	terms, err := parseSort(c.Query("sort"), userSortFields)
+	if err != nil {
+		return nil, nil, err
+	}
+	order := make([]user.OrderOption, len(terms))
+	for i, t := range terms {
+		order[i] = t
+	}
+
+	return preds, order, nil
+}
+
+// blogListOptions builds the filters and ordering for GET /blogs.
+// Supported: author_id, status, tag, category, created_after, created_before, q (title/content) and sort.
+func blogListOptions(c *fiber.Ctx, page pageParams) ([]predicate.Blog, []blog.OrderOption, error) {
+	var preds []predicate.Blog
+
+	if id, ok, err := queryInt(c, "author_id"); err != nil {
+		return nil, nil, err
+	} else if ok {
+		preds = append(preds, blog.HasAuthorWith(user.ID(id)))
+	}
+	if status := c.Query("status"); status != "" {
+		if err := blog.StatusValidator(blog.Status(status)); err != nil {
+			return nil, nil, fmt.Errorf("invalid status %q", status)
+		}
+		preds = append(preds, blog.StatusEQ(blog.Status(status)))
+	}
+	if slugs := queryList(c, "tag"); len(slugs) > 0 {
+		preds = append(preds, blog.HasTagsWith(tag.SlugIn(slugs...)))
+	}
+	if slugs := queryList(c, "category"); len(slugs) > 0 {
+		preds = append(preds, blog.HasCategoriesWith(category.SlugIn(slugs...)))
+	}
+	if t, ok, err := queryTime(c, "created_after"); err != nil {
+		return nil, nil, err
+	} else if ok {
+		preds = append(preds, blog.CreatedAtGTE(t))
+	}
+	if t, ok, err := queryTime(c, "created_before"); err != nil {
+		return nil, nil, err
+	} else if ok {
+		preds = append(preds, blog.CreatedAtLT(t))
+	}
+	if q := c.Query("q"); q != "" {
+		preds = append(preds, blog.Or(blog.TitleContains(q), blog.ContentContains(q)))
+	}
+
+	if page.CursorMode {
+		if c.Query("sort") != "" {
+			return nil, nil, errSortWithCursor
+		}
+		return preds, []blog.OrderOption{
+			blog.ByCreatedAt(sql.OrderDesc()),
+			blog.ByID(sql.OrderDesc()),
+		}, nil
+	}
+
+	terms, err := parseSort(c.Query("sort"), blogSortFields)
+	if err != nil {
+		return nil, nil, err
+	}
+	order := make([]blog.OrderOption, len(terms))
+	for i, t := range terms {
+		order[i] = t
+	}
+
+	return preds, order, nil
+}
+
+// commentListOptions builds the filters and ordering for GET /comments.
+// Supported: blog_id, author_id, created_after, created_before, q (content) and sort.
+func commentListOptions(c *fiber.Ctx, page pageParams) ([]predicate.Comment, []comment.OrderOption, error) {
+	var preds []predicate.Comment
+
+	if id, ok, err := queryInt(c, "blog_id"); err != nil {
+		return nil, nil, err
+	} else if ok {
+		preds = append(preds, comment.HasBlogWith(blog.ID(id)))
+	}
+	if id, ok, err := queryInt(c, "author_id"); err != nil {
+		return nil, nil, err
+	} else if ok {
+		preds = append(preds, comment.HasAuthorWith(user.ID(id)))
+	}
+	if t, ok, err := queryTime(c, "created_after"); err != nil {
+		return nil, nil, err
+	} else if ok {
+		preds = append(preds, comment.CreatedAtGTE(t))
+	}
+	if t, ok, err := queryTime(c, "created_before"); err != nil {
+		return nil, nil, err
+	} else if ok {
+		preds = append(preds, comment.CreatedAtLT(t))
+	}
+	if q := c.Query("q"); q != "" {
+		preds = append(preds, comment.ContentContains(q), comment.TombstonedAtIsNil())
+	}
+
+	if page.CursorMode {
+		if c.Query("sort") != "" {
+			return nil, nil, errSortWithCursor
+		}
+		return preds, []comment.OrderOption{
+			comment.ByCreatedAt(sql.OrderDesc()),
+			comment.ByID(sql.OrderDesc()),
+		}, nil
+	}
+
+	terms, err := parseSort(c.Query("sort"), commentSortFields)
+	if err != nil {
+		return nil, nil, err
+	}
+	order := make([]comment.OrderOption, len(terms))
+	for i, t := range terms {
+		order[i] = t
+	}
+
+	return preds, order, nil
+}
+
+// auditListOptions builds the filters for GET /audit, which is always ordered newest first.
+// Supported: actor_id, entity_type, entity_id, operation, request_id, created_after and created_before.
+func auditListOptions(c *fiber.Ctx) ([]predicate.AuditLog, error) {
+	var preds []predicate.AuditLog
+
+	if id, ok, err := queryInt(c, "actor_id"); err != nil {
+		return nil, err
+	} else if ok {
+		preds = append(preds, auditlog.ActorID(id))
+	}
+	if t := c.Query("entity_type"); t != "" {
+		preds = append(preds, auditlog.EntityType(t))
+	}
+	if id, ok, err := queryInt(c, "entity_id"); err != nil {
+		return nil, err
+	} else if ok {
+		preds = append(preds, auditlog.EntityID(id))
+	}
+	if op := c.Query("operation"); op != "" {
+		if err := auditlog.OperationValidator(auditlog.Operation(op)); err != nil {
+			return nil, fmt.Errorf("invalid operation %q", op)
+		}
+		preds = append(preds, auditlog.OperationEQ(auditlog.Operation(op)))
+	}
+	if id := c.Query("request_id"); id != "" {
+		preds = append(preds, auditlog.RequestID(id))
+	}
+	if t, ok, err := queryTime(c, "created_after"); err != nil {
+		return nil, err
+	} else if ok {
+		preds = append(preds, auditlog.CreatedAtGTE(t))
+	}
+	if t, ok, err := queryTime(c, "created_before"); err != nil {
+		return nil, err
+	} else if ok {
+		preds = append(preds, auditlog.CreatedAtLT(t))
+	}
+
+	return preds, nil
+}
+
+// userAfterCursor selects users that come after cur in (created_at, id) descending order
+func userAfterCursor(cur *pageCursor) predicate.User {
+	return user.Or(
+		user.CreatedAtLT(cur.CreatedAt),
+		user.And(user.CreatedAtEQ(cur.CreatedAt), user.IDLT(cur.ID)),
+	)
+}
+
+// addressAfterCursor selects addresses that come after cur in (created_at, id) descending order
+func addressAfterCursor(cur *pageCursor) predicate.Address {
+	return address.Or(
+		address.CreatedAtLT(cur.CreatedAt),
+		address.And(address.CreatedAtEQ(cur.CreatedAt), address.IDLT(cur.ID)),
+	)
+}
+
+// blogAfterCursor selects blogs that come after cur in (created_at, id) descending order
+func blogAfterCursor(cur *pageCursor) predicate.Blog {
+	return blog.Or(
+		blog.CreatedAtLT(cur.CreatedAt),
+		blog.And(blog.CreatedAtEQ(cur.CreatedAt), blog.IDLT(cur.ID)),
+	)
+}
+
+// commentAfterCursor selects comments that come after cur in (created_at, id) descending order
+func commentAfterCursor(cur *pageCursor) predicate.Comment {
+	return comment.Or(
+		comment.CreatedAtLT(cur.CreatedAt),
+		comment.And(comment.CreatedAtEQ(cur.CreatedAt), comment.IDLT(cur.ID)),
+	)
+}
+
+// auditAfterCursor selects audit entries that come after cur in (created_at, id) descending order
+func auditAfterCursor(cur *pageCursor) predicate.AuditLog {
+	return auditlog.Or(
+		auditlog.CreatedAtLT(cur.CreatedAt),
+		auditlog.And(auditlog.CreatedAtEQ(cur.CreatedAt), auditlog.IDLT(cur.ID)),
+	)
+}
+
+// revisionAfterCursor selects blog revisions that come after cur in (created_at, id) descending order
+func revisionAfterCursor(cur *pageCursor) predicate.BlogRevision {
+	return blogrevision.Or(
+		blogrevision.CreatedAtLT(cur.CreatedAt),
+		blogrevision.And(blogrevision.CreatedAtEQ(cur.CreatedAt), blogrevision.IDLT(cur.ID)),
+	)
+}
@@ -0,0 +1,284 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/blog"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/blogrevision"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/apierror"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/dto"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/textdiff"
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetRevisions returns the revision history of a blog
+// @Security Bearer
+// @Summary Get blog revisions
+// @Description List the stored versions of a blog, newest first. A revision is recorded whenever the title or content is written. Users may only list the revisions of their own blogs, admins those of any.
+// @Tags blogs
+// @Produce json
+// @Param id path int true "Blog ID"
+// @Param page query int false "Page number"
+// @Param limit query int false "Page size"
+// @Param cursor query string false "Keyset cursor from next_cursor; pass empty to start cursor mode"
+// @Param include_total query bool false "Include the total count (default true)"
+// @Success 200 {object} dto.PaginatedBlogRevisionResponse
+// @Failure 403 {object} dto.ErrorResponse "Not the owner"
+// @Failure 404 {object} dto.ErrorResponse "Not found"
+// @Router /blogs/{id}/revisions [get]
+func (h *BlogHandler) GetRevisions(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return apierror.ErrInvalidID
+	}
+
+	page, err := parsePageParams(c)
+	if err != nil {
+		return apierror.BadRequest(err.Error())
+	}
+
+	ctx := c.UserContext()
+	if _, err := h.historyBlog(c, id); err != nil {
+		return err
+	}
+	query := h.client.BlogRevision.Query().Where(blogrevision.HasBlogWith(blog.ID(id)))
+
+	// Get total count (optional, it costs a full scan on large tables)
+	var total *int
+	if page.IncludeTotal {
+		n, err := query.Clone().Count(ctx)
+		if err != nil {
+			return apierror.FromEnt(err, nil)
+		}
+		total = &n
+	}
+
+	if page.Cursor != nil {
+		query.Where(revisionAfterCursor(page.Cursor))
+	}
+
+	revisions, err := query.
+		WithEditor().
+		Order(blogrevision.ByCreatedAt(sql.OrderDesc()), blogrevision.ByID(sql.OrderDesc())).
+		Limit(page.Limit + 1).
+		Offset(page.Offset).
+		All(ctx)
+	if err != nil {
+		return apierror.FromEnt(err, nil)
+	}
+
+	revisions, hasMore, nextCursor := trimPage(revisions, page, func(row *ent.BlogRevision) (time.Time, int) {
+		return row.CreatedAt, row.ID
+	})
+
+	data := make([]dto.BlogRevisionResponse, 0, len(revisions))
+	for _, r := range revisions {
+		data = append(data, toBlogRevisionResponse(r))
+	}
+
+	return c.JSON(dto.PaginatedBlogRevisionResponse{
+		Data:       data,
+		Total:      total,
+		HasMore:    hasMore,
+		NextCursor: nextCursor,
+	})
+}
+
+// GetRevisionDiff compares a revision with the current blog
+// @Security Bearer
+// @Summary Diff blog revision
+// @Description Line diff from the revision's content to the current content. Deleted lines exist only in the revision, inserted lines only in the current blog. Users may only diff their own blogs, admins any. If the changed lines differ in more than 1000 places they are shown as all removed and all added.
+// @Tags blogs
+// @Produce json
+// @Param id path int true "Blog ID"
+// @Param rev path int true "Revision number"
+// @Success 200 {object} dto.BlogRevisionDiffResponse
+// @Failure 403 {object} dto.ErrorResponse "Not the owner"
+// @Failure 404 {object} dto.ErrorResponse "Blog or revision not found"
+// @Router /blogs/{id}/revisions/{rev}/diff [get]
+func (h *BlogHandler) GetRevisionDiff(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return apierror.ErrInvalidID
+	}
+
+	b, err := h.historyBlog(c, id)
+	if err != nil {
+		return err
+	}
+	r, err := h.findRevision(c, id)
+	if err != nil {
+		return err
+	}
+
+	resp := dto.BlogRevisionDiffResponse{
+		Revision:     r.Revision,
+		Title:        r.Title,
+		CurrentTitle: b.Title,
+		Lines:        []dto.DiffLine{},
+	}
+	for _, line := range textdiff.Lines(r.Content, b.Content) {
+		switch line.Op {
+		case textdiff.Insert:
+			resp.Added++
+		case textdiff.Delete:
+			resp.Removed++
+		}
+		resp.Lines = append(resp.Lines, dto.DiffLine{Op: string(line.Op), Text: line.Text})
+	}
+
+	return c.JSON(resp)
+}
+
+// RestoreRevision rolls a blog back to a revision
+// @Security Bearer
+// @Summary Restore blog revision
+// @Description Set the blog's title and content to those of the revision. This is recorded as a new revision. Users may only restore their own blogs, admins may restore any.
+// @Tags blogs
+// @Produce json
+// @Param id path int true "Blog ID"
+// @Param rev path int true "Revision number"
+// @Success 200 {object} dto.BlogResponse
+// @Failure 403 {object} dto.ErrorResponse "Not the owner"
+// @Failure 404 {object} dto.ErrorResponse "Blog or revision not found"
+// @Router /blogs/{id}/revisions/{rev}/restore [post]
+func (h *BlogHandler) RestoreRevision(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return apierror.ErrInvalidID
+	}
+
+	if err := h.checkOwner(c, id); err != nil {
+		return err
+	}
+	r, err := h.findRevision(c, id)
+	if err != nil {
+		return err
+	}
+
+	ctx := c.UserContext()
+	err = withTx(ctx, h.client, func(tx *ent.Tx) error {
+		return saveContent(ctx, tx, id, &r.Title, &r.Content, int(currentUserID(c)))
+	})
+	if err != nil {
+		return apierror.FromEnt(err, apierror.ErrBlogNotFound)
+	}
+
+	b, err := h.client.Blog.Query().
+		Where(blog.ID(id)).
+		WithAuthor().
+		WithTags(withTags).
+		WithCategories(withCategories).
+		Only(ctx)
+	if err != nil {
+		return apierror.FromEnt(err, apierror.ErrBlogNotFound)
+	}
+
+	return c.JSON(toBlogResponse(b))
+}
+
+// historyBlog loads a blog whose revisions the authenticated user may read.
+// Revisions include draft versions, so only the author and admins may.
+func (h *BlogHandler) historyBlog(c *fiber.Ctx, id int) (*ent.Blog, error) {
+	b, err := h.client.Blog.Query().
+		Where(blog.ID(id)).
+		Where(blogVisibility(c)...).
+		WithAuthor().
+		Only(c.UserContext())
+	if err != nil {
+		return nil, apierror.FromEnt(err, apierror.ErrBlogNotFound)
+	}
+	if !canModify(c, b.Edges.Author.ID) {
+		return nil, apierror.Forbidden("Only the author can view the revisions of a blog")
+	}
+	return b, nil
+}
+
+// findRevision loads the revision numbered by the rev parameter of the blog with the given ID
+func (h *BlogHandler) findRevision(c *fiber.Ctx, blogID int) (*ent.BlogRevision, error) {
+	rev, err := strconv.Atoi(c.Params("rev"))
+	if err != nil {
+		return nil, apierror.ErrInvalidID
+	}
+
+	r, err := h.client.BlogRevision.Query().
+		Where(blogrevision.Revision(rev), blogrevision.HasBlogWith(blog.ID(blogID))).
+		WithEditor().
+		Only(c.UserContext())
+	if err != nil {
+		return nil, apierror.FromEnt(err, apierror.ErrRevisionNotFound)
+	}
+	return r, nil
+}
+
+// saveContent updates the title and content of a blog and records the result
+// as a new revision. Nil values are kept; nothing is written when nothing changes.
+func saveContent(ctx context.Context, tx *ent.Tx, id int, title, content *string, editorID int) error {
+	b, err := tx.Blog.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	update := tx.Blog.UpdateOne(b)
+	changed := false
+	if title != nil && *title != b.Title {
+		update.SetTitle(*title)
+		changed = true
+	}
+	if content != nil && *content != b.Content {
+		update.SetContent(*content)
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+
+	if b, err = update.Save(ctx); err != nil {
+		return err
+	}
+	return addRevision(ctx, tx, b, editorID)
+}
+
+// addRevision stores the current title and content of b as its next revision
+func addRevision(ctx context.Context, tx *ent.Tx, b *ent.Blog, editorID int) error {
+	next := 1
+	last, err := tx.BlogRevision.Query().
+		Where(blogrevision.HasBlogWith(blog.ID(b.ID))).
+		Order(ent.Desc(blogrevision.FieldRevision)).
+		First(ctx)
+	switch {
+	case err == nil:
+		next = last.Revision + 1
+	case !ent.IsNotFound(err):
+		return fmt.Errorf("failed to look up last revision: %w", err)
+	}
+
+	return tx.BlogRevision.Create().
+		SetBlog(b).
+		SetRevision(next).
+		SetTitle(b.Title).
+		SetContent(b.Content).
+		SetEditorID(editorID).
+		Exec(ctx)
+}
+
+// toBlogRevisionResponse maps a revision to the API response. The editor edge must be loaded.
+func toBlogRevisionResponse(r *ent.BlogRevision) dto.BlogRevisionResponse {
+	resp := dto.BlogRevisionResponse{
+		Revision:  r.Revision,
+		Title:     r.Title,
+		Content:   r.Content,
+		CreatedAt: r.CreatedAt,
+	}
+	if editor := r.Edges.Editor; editor != nil {
+		id := int64(editor.ID)
+		resp.EditorID = &id
+		resp.EditorUsername = editor.Username
+	}
+	return resp
+}
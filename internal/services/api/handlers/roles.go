@@ -0,0 +1,339 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/casbinrule"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/role"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/apierror"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/dto"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/softdelete"
+	"github.com/casbin/casbin/v2"
+	"github.com/gofiber/fiber/v2"
+)
+
+// Built-in roles: new accounts get defaultRole, adminRole bypasses ownership checks
+const (
+	defaultRole = "user"
+	adminRole   = "admin"
+)
+
+type RoleHandler struct {
+	client   *ent.Client
+	enforcer *casbin.SyncedEnforcer
+}
+
+func NewRoleHandler(client *ent.Client, enforcer *casbin.SyncedEnforcer) *RoleHandler {
+	return &RoleHandler{client: client, enforcer: enforcer}
+}
+
+// GetRoles returns all roles
+// @Security Bearer
+// @Summary List roles
+// @Tags roles
+// @Produce json
+// @Success 200 {array} dto.RoleResponse
+// @Router /roles [get]
+func (h *RoleHandler) GetRoles(c *fiber.Ctx) error {
+	roles, err := h.client.Role.Query().
+		WithParents().
+		Order(ent.Asc(role.FieldName)).
+		All(c.UserContext())
+	if err != nil {
+		return apierror.Internal(err)
+	}
+
+	resp := make([]dto.RoleResponse, 0, len(roles))
+	for _, r := range roles {
+		resp = append(resp, toRoleResponse(r))
+	}
+
+	return c.JSON(resp)
+}
+
+// CreateRole creates a role
+// @Security Bearer
+// @Summary Create role
+// @Description Create a role that can be assigned to users and granted permissions via /admin/policies. It inherits all permissions of its parents.
+// @Tags roles
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateRoleRequest true "Role details"
+// @Success 201 {object} dto.RoleResponse
+// @Failure 409 {object} dto.ErrorResponse "Role already exists"
+// @Failure 422 {object} dto.ErrorResponse "Validation failed"
+// @Router /roles [post]
+func (h *RoleHandler) CreateRole(c *fiber.Ctx) error {
+	var req dto.CreateRoleRequest
+	if err := bindBody(c, &req); err != nil {
+		return err
+	}
+
+	ctx := c.UserContext()
+	parents, err := findRoles(ctx, h.client, "parents", req.Parents)
+	if err != nil {
+		return err
+	}
+
+	r, err := h.client.Role.Create().
+		SetName(req.Name).
+		SetDescription(req.Description).
+		AddParents(parents...).
+		Save(ctx)
+	if err != nil {
+		return apierror.FromEnt(err, nil)
+	}
+	r.Edges.Parents = parents
+
+	if len(parents) > 0 {
+		if err := h.enforcer.LoadPolicy(); err != nil {
+			return apierror.Internal(err)
+		}
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(toRoleResponse(r))
+}
+
+// GetRole returns a single role
+// @Security Bearer
+// @Summary Get role by ID
+// @Tags roles
+// @Produce json
+// @Param id path int true "Role ID"
+// @Success 200 {object} dto.RoleResponse
+// @Failure 404 {object} dto.ErrorResponse "Not found"
+// @Router /roles/{id} [get]
+func (h *RoleHandler) GetRole(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return apierror.ErrInvalidID
+	}
+
+	r, err := h.client.Role.Query().
+		Where(role.ID(id)).
+		WithParents().
+		Only(c.UserContext())
+	if err != nil {
+		return apierror.FromEnt(err, apierror.ErrRoleNotFound)
+	}
+
+	return c.JSON(toRoleResponse(r))
+}
+
+// UpdateRole updates a role's description and parents
+// @Security Bearer
+// @Summary Update role
+// @Description The name cannot be changed since policies refer to it. Parents, when given, replace the current parents.
+// @Tags roles
+// @Accept json
+// @Produce json
+// @Param id path int true "Role ID"
+// @Param request body dto.UpdateRoleRequest true "Role details"
+// @Success 200 {object} dto.RoleResponse
+// @Failure 404 {object} dto.ErrorResponse "Not found"
+// @Failure 422 {object} dto.ErrorResponse "Validation failed or inheritance cycle"
+// @Router /roles/{id} [patch]
+func (h *RoleHandler) UpdateRole(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return apierror.ErrInvalidID
+	}
+
+	var req dto.UpdateRoleRequest
+	if err := bindBody(c, &req); err != nil {
+		return err
+	}
+
+	ctx := c.UserContext()
+	r, err := h.client.Role.Get(ctx, id)
+	if err != nil {
+		return apierror.FromEnt(err, apierror.ErrRoleNotFound)
+	}
+
+	update := r.Update()
+	if req.Description != nil {
+		update.SetDescription(*req.Description)
+	}
+	if req.Parents != nil {
+		parents, err := findRoles(ctx, h.client, "parents", req.Parents)
+		if err != nil {
+			return err
+		}
+		if err := h.checkCycle(ctx, r, parents); err != nil {
+			return err
+		}
+		update.ClearParents().AddParents(parents...)
+	}
+
+	if _, err := update.Save(ctx); err != nil {
+		return apierror.FromEnt(err, apierror.ErrRoleNotFound)
+	}
+
+	if req.Parents != nil {
+		if err := h.enforcer.LoadPolicy(); err != nil {
+			return apierror.Internal(err)
+		}
+	}
+
+	r, err = h.client.Role.Query().Where(role.ID(id)).WithParents().Only(ctx)
+	if err != nil {
+		return apierror.FromEnt(err, apierror.ErrRoleNotFound)
+	}
+
+	return c.JSON(toRoleResponse(r))
+}
+
+// DeleteRole deletes a role and its policies
+// @Security Bearer
+// @Summary Delete role
+// @Description Delete an unassigned role together with its policies. Built-in roles cannot be deleted.
+// @Tags roles
+// @Param id path int true "Role ID"
+// @Success 204 "No Content"
+// @Failure 404 {object} dto.ErrorResponse "Not found"
+// @Failure 409 {object} dto.ErrorResponse "Built-in role or still assigned to users"
+// @Router /roles/{id} [delete]
+func (h *RoleHandler) DeleteRole(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return apierror.ErrInvalidID
+	}
+
+	ctx := c.UserContext()
+	r, err := h.client.Role.Get(ctx, id)
+	if err != nil {
+		return apierror.FromEnt(err, apierror.ErrRoleNotFound)
+	}
+
+	if r.Name == defaultRole || r.Name == adminRole {
+		return apierror.New(fiber.StatusConflict, apierror.CodeConflict, "Built-in roles cannot be deleted")
+	}
+	// Soft deleted users keep their roles for a restore
+	assigned, err := r.QueryUsers().Exist(softdelete.IncludeDeleted(ctx))
+	if err != nil {
+		return apierror.Internal(err)
+	}
+	if assigned {
+		return apierror.New(fiber.StatusConflict, apierror.CodeConflict, "Role is still assigned to users")
+	}
+
+	// The role, its permissions and any stored inheritance rules go together,
+	// so a failure can't leave rules behind that a new role of that name would inherit
+	err = withTx(ctx, h.client, func(tx *ent.Tx) error {
+		_, err := tx.CasbinRule.Delete().
+			Where(casbinrule.Or(
+				casbinrule.And(casbinrule.Ptype("p"), casbinrule.V0(r.Name)),
+				casbinrule.And(casbinrule.Ptype("g"), casbinrule.Or(casbinrule.V0(r.Name), casbinrule.V1(r.Name))),
+			)).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to remove casbin rules: %w", err)
+		}
+		return tx.Role.DeleteOneID(r.ID).Exec(ctx)
+	})
+	if err != nil {
+		return apierror.FromEnt(err, apierror.ErrRoleNotFound)
+	}
+
+	if err := h.enforcer.LoadPolicy(); err != nil {
+		return apierror.Internal(err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// checkCycle rejects parents that already inherit from r
+func (h *RoleHandler) checkCycle(ctx context.Context, r *ent.Role, parents []*ent.Role) error {
+	seen := make(map[int]bool)
+	queue := make([]*ent.Role, len(parents))
+	copy(queue, parents)
+
+	for len(queue) > 0 {
+		p := queue[0]
+		queue = queue[1:]
+		if p.ID == r.ID {
+			return apierror.Validation([]dto.FieldError{{
+				Field:   "parents",
+				Rule:    "acyclic",
+				Message: fmt.Sprintf("role %q cannot inherit from itself", r.Name),
+			}})
+		}
+		if seen[p.ID] {
+			continue
+		}
+		seen[p.ID] = true
+
+		grandparents, err := p.QueryParents().All(ctx)
+		if err != nil {
+			return apierror.Internal(err)
+		}
+		queue = append(queue, grandparents...)
+	}
+	return nil
+}
+
+// findRoles loads the roles with the given names, reporting unknown names as a validation error on field
+func findRoles(ctx context.Context, client *ent.Client, field string, names []string) ([]*ent.Role, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	roles, err := client.Role.Query().Where(role.NameIn(names...)).All(ctx)
+	if err != nil {
+		return nil, apierror.Internal(err)
+	}
+
+	for _, name := range names {
+		if !slices.ContainsFunc(roles, func(r *ent.Role) bool { return r.Name == name }) {
+			return nil, apierror.Validation([]dto.FieldError{{
+				Field:   field,
+				Rule:    "exists",
+				Message: fmt.Sprintf("role %q does not exist", name),
+			}})
+		}
+	}
+	return roles, nil
+}
+
+// loadRoles loads the roles of u into its edges unless they were eager loaded
+func loadRoles(ctx context.Context, u *ent.User) error {
+	if u.Edges.Roles != nil {
+		return nil
+	}
+
+	roles, err := u.QueryRoles().Order(ent.Asc(role.FieldName)).All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load roles: %w", err)
+	}
+	u.Edges.Roles = roles
+	return nil
+}
+
+// withRoles eager loads user roles in name order
+func withRoles(q *ent.RoleQuery) {
+	q.Order(ent.Asc(role.FieldName))
+}
+
+// roleNames returns the names of roles
+func roleNames(roles []*ent.Role) []string {
+	names := make([]string, 0, len(roles))
+	for _, r := range roles {
+		names = append(names, r.Name)
+	}
+	return names
+}
+
+// toRoleResponse maps a role to the API response
+func toRoleResponse(r *ent.Role) dto.RoleResponse {
+	return dto.RoleResponse{
+		ID:          int64(r.ID),
+		Name:        r.Name,
+		Description: r.Description,
+		Parents:     roleNames(r.Edges.Parents),
+		CreatedAt:   r.CreatedAt,
+	}
+}
@@ -0,0 +1,291 @@
+package handlers
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/tag"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/apierror"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/dto"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/slug"
+	"github.com/gofiber/fiber/v2"
+)
+
+// Longest generated slugs, matching the schema limits
+const (
+	tagSlugLen      = 50
+	categorySlugLen = 100
+)
+
+type TagHandler struct {
+	client *ent.Client
+}
+
+func NewTagHandler(client *ent.Client) *TagHandler {
+	return &TagHandler{client: client}
+}
+
+// GetTags returns all tags
+// @Security Bearer
+// @Summary List tags
+// @Tags tags
+// @Produce json
+// @Success 200 {array} dto.TagResponse
+// @Router /tags [get]
+func (h *TagHandler) GetTags(c *fiber.Ctx) error {
+	tags, err := h.client.Tag.Query().
+		Order(ent.Asc(tag.FieldName)).
+		All(c.UserContext())
+	if err != nil {
+		return apierror.Internal(err)
+	}
+
+	resp := make([]dto.TagResponse, 0, len(tags))
+	for _, t := range tags {
+		resp = append(resp, toTagResponse(t))
+	}
+
+	return c.JSON(resp)
+}
+
+// GetTagCounts returns how many blogs carry each tag
+// @Security Bearer
+// @Summary Count blogs per tag
+// @Description Count the blogs visible to the caller per tag, most used first. Tags without such blogs are left out.
+// @Tags tags
+// @Produce json
+// @Success 200 {array} dto.TagCountResponse
+// @Router /tags/counts [get]
+func (h *TagHandler) GetTagCounts(c *fiber.Ctx) error {
+	// Unique(false) keeps one row per blog and tag for the count
+	counts := []dto.TagCountResponse{}
+	err := h.client.Blog.Query().
+		Where(blogVisibility(c)...).
+		QueryTags().
+		Unique(false).
+		GroupBy(tag.FieldID, tag.FieldName, tag.FieldSlug).
+		Aggregate(ent.As(ent.Count(), "count")).
+		Scan(c.UserContext(), &counts)
+	if err != nil {
+		return apierror.Internal(err)
+	}
+
+	slices.SortFunc(counts, func(a, b dto.TagCountResponse) int {
+		return cmp.Or(cmp.Compare(b.Count, a.Count), cmp.Compare(a.Name, b.Name))
+	})
+
+	return c.JSON(counts)
+}
+
+// CreateTag creates a tag
+// @Security Bearer
+// @Summary Create tag
+// @Description Create a tag. The slug is generated from the name unless given. Tags are also created when a blog names a missing one.
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateTagRequest true "Tag details"
+// @Success 201 {object} dto.TagResponse
+// @Failure 409 {object} dto.ErrorResponse "Slug already exists"
+// @Failure 422 {object} dto.ErrorResponse "Validation failed"
+// @Router /tags [post]
+func (h *TagHandler) CreateTag(c *fiber.Ctx) error {
+	var req dto.CreateTagRequest
+	if err := bindBody(c, &req); err != nil {
+		return err
+	}
+
+	s, err := makeSlug("slug", req.Slug, req.Name, tagSlugLen)
+	if err != nil {
+		return err
+	}
+
+	t, err := h.client.Tag.Create().
+		SetName(req.Name).
+		SetSlug(s).
+		Save(c.UserContext())
+	if err != nil {
+		return apierror.FromEnt(err, nil)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(toTagResponse(t))
+}
+
+// GetTag returns a single tag
+// @Security Bearer
+// @Summary Get tag by ID
+// @Tags tags
+// @Produce json
+// @Param id path int true "Tag ID"
+// @Success 200 {object} dto.TagResponse
+// @Failure 404 {object} dto.ErrorResponse "Not found"
+// @Router /tags/{id} [get]
+func (h *TagHandler) GetTag(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return apierror.ErrInvalidID
+	}
+
+	t, err := h.client.Tag.Get(c.UserContext(), id)
+	if err != nil {
+		return apierror.FromEnt(err, apierror.ErrTagNotFound)
+	}
+
+	return c.JSON(toTagResponse(t))
+}
+
+// UpdateTag renames a tag
+// @Security Bearer
+// @Summary Update tag
+// @Description Renaming a tag keeps its slug, so links stay valid, unless a new slug is given.
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param id path int true "Tag ID"
+// @Param request body dto.UpdateTagRequest true "Tag details"
+// @Success 200 {object} dto.TagResponse
+// @Failure 404 {object} dto.ErrorResponse "Not found"
+// @Failure 409 {object} dto.ErrorResponse "Slug already exists"
+// @Failure 422 {object} dto.ErrorResponse "Validation failed"
+// @Router /tags/{id} [patch]
+func (h *TagHandler) UpdateTag(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return apierror.ErrInvalidID
+	}
+
+	var req dto.UpdateTagRequest
+	if err := bindBody(c, &req); err != nil {
+		return err
+	}
+
+	update := h.client.Tag.UpdateOneID(id)
+	if req.Name != nil {
+		update.SetName(*req.Name)
+	}
+	if req.Slug != nil {
+		update.SetSlug(*req.Slug)
+	}
+
+	t, err := update.Save(c.UserContext())
+	if err != nil {
+		return apierror.FromEnt(err, apierror.ErrTagNotFound)
+	}
+
+	return c.JSON(toTagResponse(t))
+}
+
+// DeleteTag deletes a tag
+// @Security Bearer
+// @Summary Delete tag
+// @Description Delete a tag and remove it from all blogs.
+// @Tags tags
+// @Param id path int true "Tag ID"
+// @Success 204 "No Content"
+// @Failure 404 {object} dto.ErrorResponse "Not found"
+// @Router /tags/{id} [delete]
+func (h *TagHandler) DeleteTag(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return apierror.ErrInvalidID
+	}
+
+	if err := h.client.Tag.DeleteOneID(id).Exec(c.UserContext()); err != nil {
+		return apierror.FromEnt(err, apierror.ErrTagNotFound)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// makeSlug returns explicit when set, otherwise the slug of name. A name
+// without letters or digits is reported as a validation error on field.
+func makeSlug(field, explicit, name string, maxLen int) (string, error) {
+	if explicit != "" {
+		return explicit, nil
+	}
+	s := slug.Make(name, maxLen)
+	if s == "" {
+		return "", apierror.Validation([]dto.FieldError{{
+			Field:   field,
+			Rule:    "slug",
+			Message: fmt.Sprintf("cannot generate a slug from %q, it needs a letter or digit", name),
+		}})
+	}
+	return s, nil
+}
+
+// findOrCreateTags loads the tags with the given names, matched by slug. Missing
+// tags are created when create is set (admins, who may POST /tags) and
+// rejected otherwise.
+func findOrCreateTags(ctx context.Context, tx *ent.Tx, names []string, create bool) ([]*ent.Tag, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	slugs := make([]string, 0, len(names))
+	for _, name := range names {
+		s, err := makeSlug("tags", "", name, tagSlugLen)
+		if err != nil {
+			return nil, err
+		}
+		slugs = append(slugs, s)
+	}
+
+	tags, err := tx.Tag.Query().Where(tag.SlugIn(slugs...)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tags: %w", err)
+	}
+
+	var missing []string
+	for i, s := range slugs {
+		if slices.ContainsFunc(tags, func(t *ent.Tag) bool { return t.Slug == s }) {
+			continue
+		}
+		if !create {
+			missing = append(missing, strconv.Quote(names[i]))
+			continue
+		}
+		t, err := tx.Tag.Create().SetName(names[i]).SetSlug(s).Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, t)
+	}
+	if len(missing) > 0 {
+		return nil, apierror.Validation([]dto.FieldError{{
+			Field:   "tags",
+			Rule:    "exists",
+			Message: fmt.Sprintf("tags %s do not exist, only admins can create tags", strings.Join(missing, ", ")),
+		}})
+	}
+	return tags, nil
+}
+
+// withTags eager loads blog tags in name order
+func withTags(q *ent.TagQuery) {
+	q.Order(ent.Asc(tag.FieldName))
+}
+
+// toTagRefs maps tags to the references embedded in blog responses
+func toTagRefs(tags []*ent.Tag) []dto.TagRef {
+	refs := make([]dto.TagRef, 0, len(tags))
+	for _, t := range tags {
+		refs = append(refs, dto.TagRef{Name: t.Name, Slug: t.Slug})
+	}
+	return refs
+}
+
+// toTagResponse maps a tag to the API response
+func toTagResponse(t *ent.Tag) dto.TagResponse {
+	return dto.TagResponse{
+		ID:        int64(t.ID),
+		Name:      t.Name,
+		Slug:      t.Slug,
+		CreatedAt: t.CreatedAt,
+	}
+}
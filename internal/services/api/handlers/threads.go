@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/blog"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/comment"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/apierror"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/dto"
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetBlogComments returns the comments of a blog
+// @Security Bearer
+// @Summary Get blog comments
+// @Description With tree=true all comments of a blog are returned at once as nested threads, oldest first; replies whose parent is hidden are left out. Without it a page of comments is returned as dto.PaginatedCommentResponse, like GET /comments.
+// @Tags blogs
+// @Produce json
+// @Param id path int true "Blog ID"
+// @Param tree query bool false "Return nested threads instead of a page"
+// @Param page query int false "Page number"
+// @Param limit query int false "Page size"
+// @Param cursor query string false "Keyset cursor from next_cursor; pass empty to start cursor mode (newest first)"
+// @Param include_total query bool false "Include the total count (default true)"
+// @Param sort query string false "Comma separated sort fields, prefix with - for descending (id, created_at, updated_at)"
+// @Param include_deleted query bool false "Include soft deleted comments (admin only)"
+// @Success 200 {array} dto.CommentNode "With tree=true"
+// @Failure 400 {object} dto.ErrorResponse "Invalid filter or sort"
+// @Failure 403 {object} dto.ErrorResponse "include_deleted requires admin"
+// @Failure 404 {object} dto.ErrorResponse "Blog not found"
+// @Router /blogs/{id}/comments [get]
+func (h *CommentHandler) GetBlogComments(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return apierror.ErrInvalidID
+	}
+
+	b, err := h.client.Blog.Query().
+		Where(blog.ID(id)).
+		Where(blogVisibility(c)...).
+		Only(c.UserContext())
+	if err != nil {
+		return apierror.FromEnt(err, apierror.ErrBlogNotFound)
+	}
+
+	if !c.QueryBool("tree") {
+		return h.listComments(c, comment.HasBlogWith(blog.ID(id)))
+	}
+
+	ctx, err := queryContext(c)
+	if err != nil {
+		return err
+	}
+
+	comments, err := h.client.Comment.Query().
+		Where(comment.HasBlogWith(blog.ID(id))).
+		WithAuthor().
+		Order(ent.Asc(comment.FieldCreatedAt), ent.Asc(comment.FieldID)).
+		All(ctx)
+	if err != nil {
+		return apierror.FromEnt(err, nil)
+	}
+
+	replies := make(map[int][]*ent.Comment)
+	for _, cm := range comments {
+		cm.Edges.Blog = b
+		if cm.ParentID != nil {
+			replies[*cm.ParentID] = append(replies[*cm.ParentID], cm)
+		}
+	}
+
+	tree := []dto.CommentNode{}
+	for _, cm := range comments {
+		if cm.ParentID == nil {
+			tree = append(tree, commentNode(cm, replies))
+		}
+	}
+
+	return c.JSON(tree)
+}
+
+// commentNode builds the thread below cm from the replies grouped by parent ID
+func commentNode(cm *ent.Comment, replies map[int][]*ent.Comment) dto.CommentNode {
+	node := dto.CommentNode{
+		CommentResponse: toCommentResponse(cm),
+		Replies:         make([]dto.CommentNode, 0, len(replies[cm.ID])),
+	}
+	node.ReplyCount = len(replies[cm.ID])
+	for _, r := range replies[cm.ID] {
+		node.Replies = append(node.Replies, commentNode(r, replies))
+	}
+	return node
+}
+
+// commentResponses maps comments to API responses with their reply counts.
+// The author and blog edges must be loaded.
+func commentResponses(ctx context.Context, client *ent.Client, comments []*ent.Comment) ([]dto.CommentResponse, error) {
+	ids := make([]int, 0, len(comments))
+	for _, cm := range comments {
+		ids = append(ids, cm.ID)
+	}
+
+	var counts []struct {
+		ParentID int `json:"parent_id"`
+		Count    int `json:"count"`
+	}
+	if len(ids) > 0 {
+		err := client.Comment.Query().
+			Where(comment.ParentIDIn(ids...)).
+			GroupBy(comment.FieldParentID).
+			Aggregate(ent.As(ent.Count(), "count")).
+			Scan(ctx, &counts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count replies: %w", err)
+		}
+	}
+	replyCount := make(map[int]int, len(counts))
+	for _, rc := range counts {
+		replyCount[rc.ParentID] = rc.Count
+	}
+
+	resp := make([]dto.CommentResponse, 0, len(comments))
+	for _, cm := range comments {
+		r := toCommentResponse(cm)
+		r.ReplyCount = replyCount[cm.ID]
+		resp = append(resp, r)
+	}
+	return resp, nil
+}
+
+// commentResponse maps a single comment like commentResponses
+func commentResponse(ctx context.Context, client *ent.Client, cm *ent.Comment) (dto.CommentResponse, error) {
+	resp, err := commentResponses(ctx, client, []*ent.Comment{cm})
+	if err != nil {
+		return dto.CommentResponse{}, err
+	}
+	return resp[0], nil
+}
@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/refreshtoken"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/dto"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/middleware"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// newOpaqueToken returns a random URL-safe token and its SHA-256 hash for storage
+func newOpaqueToken() (token, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", fmt.Errorf("failed to generate token: %w", err)
+	}
+	token = base64.RawURLEncoding.EncodeToString(b)
+	return token, hashToken(token), nil
+}
+
+// hashToken returns the hex SHA-256 of an opaque token
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// issueTokens creates an access token and a refresh token for u.
+// An empty family starts a new login session; rotations pass the family of the token they replace.
+func issueTokens(ctx context.Context, client *ent.Client, u *ent.User, family string) (dto.LoginResponse, error) {
+	if family == "" {
+		var err error
+		if family, _, err = newOpaqueToken(); err != nil {
+			return dto.LoginResponse{}, err
+		}
+	}
+
+	refresh, refreshHash, err := newOpaqueToken()
+	if err != nil {
+		return dto.LoginResponse{}, err
+	}
+
+	_, err = client.RefreshToken.Create().
+		SetTokenHash(refreshHash).
+		SetFamily(family).
+		SetExpiresAt(time.Now().Add(middleware.RefreshTokenTTL())).
+		SetUserID(u.ID).
+		Save(ctx)
+	if err != nil {
+		return dto.LoginResponse{}, fmt.Errorf("failed to store refresh token: %w", err)
+	}
+
+	if err := loadRoles(ctx, u); err != nil {
+		return dto.LoginResponse{}, err
+	}
+	roles := roleNames(u.Edges.Roles)
+
+	access, err := middleware.GenerateToken(int64(u.ID), u.Username, roles)
+	if err != nil {
+		return dto.LoginResponse{}, err
+	}
+
+	return dto.LoginResponse{
+		Token:        access,
+		RefreshToken: refresh,
+		ExpiresIn:    int64(middleware.AccessTokenTTL().Seconds()),
+		User: dto.UserSummary{
+			ID:       int64(u.ID),
+			Username: u.Username,
+			Email:    u.Email,
+			Roles:    roles,
+		},
+	}, nil
+}
+
+// revokeFamily revokes every still-active refresh token of a login session
+func revokeFamily(ctx context.Context, client *ent.Client, family string) error {
+	_, err := client.RefreshToken.Update().
+		Where(refreshtoken.Family(family), refreshtoken.RevokedAtIsNil()).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	return err
+}
+
+// purposeClaims are the claims of a short-lived signed token used for a single
+// step of a flow (email verification, 2FA challenge). Purpose keeps one kind of
+// token, or an access token, from being accepted in place of another.
+type purposeClaims struct {
+	Email   string `json:"email,omitempty"`
+	Purpose string `json:"purpose"`
+	jwt.RegisteredClaims
+}
+
+// signPurposeToken returns a signed token for userID valid for ttl
+func signPurposeToken(purpose string, userID int, email string, ttl time.Duration) (string, error) {
+	jti, _, err := newOpaqueToken()
+	if err != nil {
+		return "", err
+	}
+
+	claims := purposeClaims{
+		Email:   email,
+		Purpose: purpose,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        jti,
+			Subject:   strconv.Itoa(userID),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(ttl)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			Issuer:    "crud-solution",
+		},
+	}
+
+	return middleware.SignClaims(claims)
+}
+
+// parsePurposeToken validates a token issued by signPurposeToken for purpose
+func parsePurposeToken(raw, purpose string) (*purposeClaims, error) {
+	claims := &purposeClaims{}
+	_, err := middleware.ParseClaims(raw, claims, jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, err
+	}
+	if claims.Purpose != purpose {
+		return nil, fmt.Errorf("token is not a %s token", purpose)
+	}
+	return claims, nil
+}
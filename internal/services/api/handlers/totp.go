@@ -0,0 +1,178 @@
+package handlers
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/config"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/user"
+)
+
+// TOTP parameters (RFC 6238 defaults understood by all authenticator apps)
+const (
+	totpPeriod        = 30 * time.Second
+	totpDigits        = 6
+	totpSkew          = 1 // Accepted steps before/after the current one
+	recoveryCodeCount = 10
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// newTOTPSecret returns a random 160-bit base32 secret
+func newTOTPSecret() (string, error) {
+	b := make([]byte, 20)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate totp secret: %w", err)
+	}
+	return totpEncoding.EncodeToString(b), nil
+}
+
+// totpURL returns the otpauth:// URL used to enroll secret in an authenticator app
+func totpURL(secret, account string) string {
+	issuer := config.AppConfig.API.Name
+	v := url.Values{}
+	v.Set("secret", secret)
+	v.Set("issuer", issuer)
+	v.Set("algorithm", "SHA1")
+	v.Set("digits", fmt.Sprint(totpDigits))
+	v.Set("period", fmt.Sprint(int(totpPeriod.Seconds())))
+	return fmt.Sprintf("otpauth://totp/%s:%s?%s", url.PathEscape(issuer), url.PathEscape(account), v.Encode())
+}
+
+// totpCode computes the code of secret for a time step
+func totpCode(secret string, step uint64) (string, error) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return "", fmt.Errorf("invalid totp secret: %w", err)
+	}
+
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], step)
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	// Dynamic truncation (RFC 4226 section 5.3)
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", totpDigits, value%uint32(math.Pow10(totpDigits))), nil
+}
+
+// validateTOTP reports whether code is valid for secret at time now, and the
+// time step it belongs to. Codes of steps up to lastStep are rejected as replays.
+func validateTOTP(secret, code string, lastStep int64, now time.Time) (int64, bool) {
+	if len(code) != totpDigits {
+		return 0, false
+	}
+
+	current := now.Unix() / int64(totpPeriod.Seconds())
+	for step := max(current-totpSkew, lastStep+1); step <= current+totpSkew; step++ {
+		expected, err := totpCode(secret, uint64(step))
+		if err != nil {
+			return 0, false
+		}
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// newRecoveryCodes returns fresh recovery codes and their hashes for storage
+func newRecoveryCodes() (codes, hashes []string, err error) {
+	for i := 0; i < recoveryCodeCount; i++ {
+		b := make([]byte, 5)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, fmt.Errorf("failed to generate recovery code: %w", err)
+		}
+		code := strings.ToLower(totpEncoding.EncodeToString(b))
+		code = code[:4] + "-" + code[4:]
+		codes = append(codes, code)
+		hashes = append(hashes, hashToken(code))
+	}
+	return codes, hashes, nil
+}
+
+// useRecoveryCode returns hashes without the hash of code, and whether code was found
+func useRecoveryCode(hashes []string, code string) ([]string, bool) {
+	h := hashToken(strings.ToLower(strings.TrimSpace(code)))
+	for i, stored := range hashes {
+		if subtle.ConstantTimeCompare([]byte(stored), []byte(h)) == 1 {
+			remaining := append([]string{}, hashes[:i]...)
+			return append(remaining, hashes[i+1:]...), true
+		}
+	}
+	return hashes, false
+}
+
+const (
+	mfaChallengeTTL     = 5 * time.Minute
+	purposeMFAChallenge = "mfa_challenge"
+)
+
+// newMFAChallenge returns the token a client exchanges, together with a 2FA code,
+// for a token pair once the password step of the login succeeded
+func newMFAChallenge(userID int) (string, error) {
+	return signPurposeToken(purposeMFAChallenge, userID, "", mfaChallengeTTL)
+}
+
+// parseMFAChallenge validates a challenge token and returns the user ID it was
+// issued for. Its jti is recorded as revoked once exchanged.
+func parseMFAChallenge(raw string) (int, *purposeClaims, error) {
+	claims, err := parsePurposeToken(raw, purposeMFAChallenge)
+	if err != nil {
+		return 0, nil, err
+	}
+	if claims.ID == "" {
+		return 0, nil, fmt.Errorf("challenge token has no jti")
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	return id, claims, err
+}
+
+// checkSecondFactor accepts either a current TOTP code or an unused recovery code of u.
+// A TOTP code is accepted once and only after the last accepted one; a used
+// recovery code is removed. The user row is locked meanwhile, so concurrent
+// requests cannot both spend the same code or restore a spent one.
+func checkSecondFactor(ctx context.Context, client *ent.Client, u *ent.User, code string) (bool, error) {
+	code = strings.TrimSpace(code)
+
+	ok := false
+	err := withTx(ctx, client, func(tx *ent.Tx) error {
+		locked, err := tx.User.Query().Where(user.ID(u.ID)).ForUpdate().Only(ctx)
+		if err != nil {
+			return err
+		}
+		if locked.TotpEnabledAt == nil {
+			return nil
+		}
+
+		if step, valid := validateTOTP(locked.TotpSecret, code, locked.TotpLastStep, time.Now()); valid {
+			ok = true
+			return tx.User.UpdateOne(locked).SetTotpLastStep(step).Exec(ctx)
+		}
+
+		remaining, found := useRecoveryCode(locked.TotpRecoveryCodes, code)
+		if !found {
+			return nil
+		}
+		ok = true
+		return tx.User.UpdateOne(locked).SetTotpRecoveryCodes(remaining).Exec(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	return ok, nil
+}
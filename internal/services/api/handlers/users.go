@@ -1,14 +1,16 @@
 package handlers
 
 import (
-	"context"
 	"strconv"
+	"time"
 
 	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/refreshtoken"
 	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/user"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/apierror"
 	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/dto"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/softdelete"
 	"github.com/gofiber/fiber/v2"
-	"golang.org/x/crypto/bcrypt"
 )
 
 type UserHandler struct {
@@ -22,49 +24,80 @@ func NewUserHandler(client *ent.Client) *UserHandler {
 // GetUsers returns list of users
 // @Security Bearer
 // @Summary Get all users
-// @Description Get list of users with pagination
+// @Description Get list of users with pagination, filtering, search and sorting
 // @Tags users
 // @Accept json
 // @Produce json
 // @Param page query int false "Page number"
 // @Param limit query int false "Page size"
+// @Param cursor query string false "Keyset cursor from next_cursor; pass empty to start cursor mode (newest first)"
+// @Param include_total query bool false "Include the total count (default true)"
+// @Param sort query string false "Comma separated sort fields, prefix with - for descending (id, username, email, created_at, updated_at)"
+// @Param q query string false "Search in username and email"
+// @Param role query string false "Filter by role name"
+// @Param created_after query string false "Created at or after (RFC 3339 or YYYY-MM-DD)"
+// @Param created_before query string false "Created before (RFC 3339 or YYYY-MM-DD)"
+// @Param include_deleted query bool false "Include soft deleted users (admin only)"
 // @Success 200 {object} dto.PaginatedUserResponse
+// @Failure 400 {object} dto.ErrorResponse "Invalid filter or sort"
+// @Failure 403 {object} dto.ErrorResponse "include_deleted requires admin"
 // @Router /users [get]
 func (h *UserHandler) GetUsers(c *fiber.Ctx) error {
-	page, _ := strconv.Atoi(c.Query("page", "1"))
-	limit, _ := strconv.Atoi(c.Query("limit", "10"))
-	offset := (page - 1) * limit
+	page, err := parsePageParams(c)
+	if err != nil {
+		return apierror.BadRequest(err.Error())
+	}
+
+	ctx, err := queryContext(c)
+	if err != nil {
+		return err
+	}
 
-	// Get total count
-	total, err := h.client.User.Query().Count(context.Background())
+	preds, order, err := userListOptions(c, page)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+		return apierror.BadRequest(err.Error())
+	}
+	query := h.client.User.Query().Where(preds...)
+
+	// Get total count (optional, it costs a full scan on large tables)
+	var total *int
+	if page.IncludeTotal {
+		n, err := query.Clone().Count(ctx)
+		if err != nil {
+			return apierror.FromEnt(err, nil)
+		}
+		total = &n
+	}
+
+	if page.Cursor != nil {
+		query.Where(userAfterCursor(page.Cursor))
 	}
 
-	users, err := h.client.User.Query().
-		Limit(limit).
-		Offset(offset).
-		All(context.Background())
+	users, err := query.
+		WithRoles(withRoles).
+		Order(order...).
+		Limit(page.Limit + 1).
+		Offset(page.Offset).
+		All(ctx)
 
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+		return apierror.FromEnt(err, nil)
 	}
 
+	users, hasMore, nextCursor := trimPage(users, page, func(row *ent.User) (time.Time, int) {
+		return row.CreatedAt, row.ID
+	})
+
 	var data []dto.UserResponse
 	for _, u := range users {
-		data = append(data, dto.UserResponse{
-			ID:        int64(u.ID),
-			Username:  u.Username,
-			Email:     u.Email,
-			Role:      u.Role.String(),
-			CreatedAt: u.CreatedAt,
-			UpdatedAt: u.UpdatedAt,
-		})
+		data = append(data, toUserResponse(u))
 	}
 
 	return c.JSON(dto.PaginatedUserResponse{
-		Data:  data,
-		Total: total,
+		Data:       data,
+		Total:      total,
+		HasMore:    hasMore,
+		NextCursor: nextCursor,
 	})
 }
 
@@ -77,34 +110,39 @@ func (h *UserHandler) GetUsers(c *fiber.Ctx) error {
 // @Produce json
 // @Param request body dto.CreateUserRequest true "User details"
 // @Success 201 {object} dto.UserResponse
+// @Failure 422 {object} dto.ErrorResponse "Validation failed"
 // @Router /users [post]
 func (h *UserHandler) CreateUser(c *fiber.Ctx) error {
 	var req dto.CreateUserRequest
-	if err := c.BodyParser(&req); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid request"})
+	if err := bindBody(c, &req); err != nil {
+		return err
+	}
+
+	ctx := c.UserContext()
+	roles, err := findRoles(ctx, h.client, "roles", req.Roles)
+	if err != nil {
+		return err
 	}
 
-	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	hashedPassword, err := hashPassword(req.Password)
+	if err != nil {
+		return apierror.Internal(err)
+	}
 
 	u, err := h.client.User.Create().
 		SetUsername(req.Username).
-		SetPassword(string(hashedPassword)).
+		SetPassword(hashedPassword).
 		SetEmail(req.Email).
-		SetRole(user.Role(req.Role)).
-		Save(context.Background())
+		AddRoles(roles...).
+		SetEmailVerifiedAt(time.Now()). // Accounts created by an admin are trusted
+		Save(ctx)
 
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+		return apierror.FromEnt(err, nil)
 	}
+	u.Edges.Roles = roles
 
-	return c.Status(fiber.StatusCreated).JSON(dto.UserResponse{
-		ID:        int64(u.ID),
-		Username:  u.Username,
-		Email:     u.Email,
-		Role:      u.Role.String(),
-		CreatedAt: u.CreatedAt,
-		UpdatedAt: u.UpdatedAt,
-	})
+	return c.Status(fiber.StatusCreated).JSON(toUserResponse(u))
 }
 
 // GetUser returns a single user
@@ -114,107 +152,221 @@ func (h *UserHandler) CreateUser(c *fiber.Ctx) error {
 // @Accept json
 // @Produce json
 // @Param id path int true "User ID"
+// @Param include_deleted query bool false "Also find a soft deleted user (admin only)"
 // @Success 200 {object} dto.UserResponse
+// @Failure 403 {object} dto.ErrorResponse "include_deleted requires admin"
+// @Failure 404 {object} dto.ErrorResponse "Not found"
 // @Router /users/{id} [get]
 func (h *UserHandler) GetUser(c *fiber.Ctx) error {
 	id, err := strconv.Atoi(c.Params("id"))
 	if err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid ID"})
+		return apierror.ErrInvalidID
 	}
 
-	u, err := h.client.User.Query().Where(user.ID(id)).Only(context.Background())
+	ctx, err := queryContext(c)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "User not found"})
-		}
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+		return err
 	}
 
-	return c.JSON(dto.UserResponse{
-		ID:        int64(u.ID),
-		Username:  u.Username,
-		Email:     u.Email,
-		Role:      u.Role.String(),
-		CreatedAt: u.CreatedAt,
-		UpdatedAt: u.UpdatedAt,
-	})
+	u, err := h.client.User.Query().
+		Where(user.ID(id)).
+		WithRoles(withRoles).
+		Only(ctx)
+	if err != nil {
+		return apierror.FromEnt(err, apierror.ErrUserNotFound)
+	}
+
+	return c.JSON(toUserResponse(u))
 }
 
 // UpdateUser updates a user
 // @Security Bearer
 // @Summary Update user
+// @Description Setting a password revokes all refresh tokens of the user.
 // @Tags users
 // @Accept json
 // @Produce json
 // @Param id path int true "User ID"
 // @Param request body dto.UpdateUserRequest true "User details"
 // @Success 200 {object} dto.UserResponse
+// @Failure 422 {object} dto.ErrorResponse "Validation failed"
+// @Failure 404 {object} dto.ErrorResponse "Not found"
 // @Router /users/{id} [patch]
 func (h *UserHandler) UpdateUser(c *fiber.Ctx) error {
 	id, err := strconv.Atoi(c.Params("id"))
 	if err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid ID"})
+		return apierror.ErrInvalidID
 	}
 
 	var req dto.UpdateUserRequest
-	if err := c.BodyParser(&req); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid request"})
+	if err := bindBody(c, &req); err != nil {
+		return err
 	}
 
-	update := h.client.User.UpdateOneID(id)
-	if req.Username != nil {
-		update.SetUsername(*req.Username)
-	}
-	if req.Email != nil {
-		update.SetEmail(*req.Email)
-	}
-	if req.Role != nil {
-		update.SetRole(user.Role(*req.Role))
+	ctx := c.UserContext()
+	var roles []*ent.Role
+	if req.Roles != nil {
+		roles, err = findRoles(ctx, h.client, "roles", req.Roles)
+		if err != nil {
+			return err
+		}
 	}
+	var hashed string
 	if req.Password != nil {
-		hashed, _ := bcrypt.GenerateFromPassword([]byte(*req.Password), bcrypt.DefaultCost)
-		update.SetPassword(string(hashed))
+		hashed, err = hashPassword(*req.Password)
+		if err != nil {
+			return apierror.Internal(err)
+		}
 	}
 
-	u, err := update.Save(context.Background())
-	if err != nil {
-		if ent.IsNotFound(err) {
-			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "User not found"})
+	var u *ent.User
+	err = withTx(ctx, h.client, func(tx *ent.Tx) error {
+		update := tx.User.UpdateOneID(id)
+		if req.Username != nil {
+			update.SetUsername(*req.Username)
+		}
+		if req.Email != nil {
+			update.SetEmail(*req.Email)
+		}
+		if req.Roles != nil {
+			update.ClearRoles().AddRoles(roles...)
+		}
+		if req.Password != nil {
+			update.SetPassword(hashed)
+		}
+
+		var err error
+		u, err = update.Save(ctx)
+		if err != nil {
+			return err
+		}
+		if req.Password == nil {
+			return nil
 		}
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
-	}
 
-	return c.JSON(dto.UserResponse{
-		ID:        int64(u.ID),
-		Username:  u.Username,
-		Email:     u.Email,
-		Role:      u.Role.String(),
-		CreatedAt: u.CreatedAt,
-		UpdatedAt: u.UpdatedAt,
+		// A new password signs the user out everywhere
+		_, err = tx.RefreshToken.Update().
+			Where(refreshtoken.HasUserWith(user.ID(id)), refreshtoken.RevokedAtIsNil()).
+			SetRevokedAt(time.Now()).
+			Save(ctx)
+		return err
 	})
+	if err != nil {
+		return apierror.FromEnt(err, apierror.ErrUserNotFound)
+	}
+	if err := loadRoles(ctx, u); err != nil {
+		return apierror.Internal(err)
+	}
+
+	return c.JSON(toUserResponse(u))
 }
 
 // DeleteUser deletes a user
 // @Security Bearer
 // @Summary Delete user
-// @Description Delete user and all related blogs/comments
+// @Description Delete user with their addresses, blogs, comments and the comments on their blogs. With soft delete enabled they are marked deleted and can be restored.
 // @Tags users
 // @Param id path int true "User ID"
 // @Success 204 "No Content"
+// @Failure 404 {object} dto.ErrorResponse "Not found"
 // @Router /users/{id} [delete]
 func (h *UserHandler) DeleteUser(c *fiber.Ctx) error {
 	id, err := strconv.Atoi(c.Params("id"))
 	if err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid ID"})
+		return apierror.ErrInvalidID
 	}
 
-	err = h.client.User.DeleteOneID(id).Exec(context.Background())
-	if err != nil {
-		if ent.IsNotFound(err) {
-			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "User not found"})
-		}
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	if err := deleteUser(c.UserContext(), h.client, id); err != nil {
+		return apierror.FromEnt(err, apierror.ErrUserNotFound)
 	}
 
 	return c.SendStatus(fiber.StatusNoContent)
 }
+
+// RestoreUser restores a soft deleted user
+// @Security Bearer
+// @Summary Restore user
+// @Description Restore a soft deleted user together with the blogs and comments deleted with them (Admin only)
+// @Tags users
+// @Produce json
+// @Param id path int true "User ID"
+// @Success 200 {object} dto.UserResponse
+// @Failure 404 {object} dto.ErrorResponse "Not found"
+// @Failure 409 {object} dto.ErrorResponse "User is not deleted"
+// @Router /users/{id}/restore [post]
+func (h *UserHandler) RestoreUser(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return apierror.ErrInvalidID
+	}
+
+	ctx := c.UserContext()
+	u, err := h.client.User.Get(softdelete.IncludeDeleted(ctx), id)
+	if err != nil {
+		return apierror.FromEnt(err, apierror.ErrUserNotFound)
+	}
+	if u.DeletedAt == nil {
+		return apierror.New(fiber.StatusConflict, apierror.CodeConflict, "User is not deleted")
+	}
+
+	if err := restoreUser(ctx, h.client, u); err != nil {
+		return apierror.FromEnt(err, apierror.ErrUserNotFound)
+	}
+
+	u, err = h.client.User.Query().
+		Where(user.ID(id)).
+		WithRoles(withRoles).
+		Only(ctx)
+	if err != nil {
+		return apierror.FromEnt(err, apierror.ErrUserNotFound)
+	}
+
+	return c.JSON(toUserResponse(u))
+}
+
+// UnlockUser clears a login lockout
+// @Security Bearer
+// @Summary Unlock user
+// @Description Clear the failed login counter and lockout of an account (Admin only)
+// @Tags users
+// @Produce json
+// @Param id path int true "User ID"
+// @Success 200 {object} dto.UserResponse
+// @Failure 404 {object} dto.ErrorResponse "Not found"
+// @Router /users/{id}/unlock [post]
+func (h *UserHandler) UnlockUser(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return apierror.ErrInvalidID
+	}
+
+	ctx := c.UserContext()
+	u, err := h.client.User.UpdateOneID(id).
+		SetFailedLoginAttempts(0).
+		ClearLockedUntil().
+		Save(ctx)
+	if err != nil {
+		return apierror.FromEnt(err, apierror.ErrUserNotFound)
+	}
+	if err := loadRoles(ctx, u); err != nil {
+		return apierror.Internal(err)
+	}
+
+	return c.JSON(toUserResponse(u))
+}
+
+// toUserResponse maps a user to the API response. The roles edge must be loaded.
+func toUserResponse(u *ent.User) dto.UserResponse {
+	return dto.UserResponse{
+		ID:              int64(u.ID),
+		Username:        u.Username,
+		Email:           u.Email,
+		Roles:           roleNames(u.Edges.Roles),
+		CreatedAt:       u.CreatedAt,
+		UpdatedAt:       u.UpdatedAt,
+		EmailVerifiedAt: u.EmailVerifiedAt,
+		LockedUntil:     u.LockedUntil,
+		TwoFactor:       u.TotpEnabledAt != nil,
+		DeletedAt:       u.DeletedAt,
+	}
+}
@@ -2,6 +2,9 @@ package handlers
 
 import (
 	"strconv"
+
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/middleware"
+	"github.com/gofiber/fiber/v2"
 )
 
 // parseID safely parses an ID from various types (string, float64, int, etc.)
@@ -25,3 +28,20 @@ func parseID(v interface{}) int64 {
 		return 0
 	}
 }
+
+// currentUserID returns the authenticated user's ID set by the JWT middleware
+func currentUserID(c *fiber.Ctx) int64 {
+	uid, _ := c.Locals("user_id").(int64)
+	return uid
+}
+
+// isAdmin reports whether the authenticated user has the admin role or a role inheriting from it
+func isAdmin(c *fiber.Ctx) bool {
+	return middleware.HasRole(c, adminRole)
+}
+
+// canModify reports whether the authenticated user may change a resource owned by ownerID.
+// Admins may change any resource, other roles only their own.
+func canModify(c *fiber.Ctx, ownerID int) bool {
+	return isAdmin(c) || currentUserID(c) == int64(ownerID)
+}
@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/apierror"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/dto"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/middleware"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/slug"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+var validate = newValidator()
+
+// newValidator creates a validator that reports fields by their JSON name
+// and knows the custom slug and API key scope rules
+func newValidator() *validator.Validate {
+	v := validator.New(validator.WithRequiredStructEnabled())
+	v.RegisterTagNameFunc(func(f reflect.StructField) string {
+		name := strings.SplitN(f.Tag.Get("json"), ",", 2)[0]
+		if name == "-" {
+			return ""
+		}
+		if name == "" {
+			return f.Name
+		}
+		return name
+	})
+	v.RegisterValidation("slug", func(fl validator.FieldLevel) bool {
+		return slug.Valid(fl.Field().String())
+	})
+	v.RegisterValidation("scope", func(fl validator.FieldLevel) bool {
+		return slices.Contains(middleware.APIKeyScopes, fl.Field().String())
+	})
+	return v
+}
+
+// bindBody parses the request body into req and evaluates its `validate` tags
+func bindBody(c *fiber.Ctx, req interface{}) error {
+	if err := c.BodyParser(req); err != nil {
+		return apierror.BadRequest("Invalid request: " + err.Error())
+	}
+
+	if err := validate.Struct(req); err != nil {
+		var verrs validator.ValidationErrors
+		if !errors.As(err, &verrs) {
+			return apierror.Internal(err)
+		}
+		return apierror.Validation(fieldErrors(verrs))
+	}
+
+	return nil
+}
+
+// fieldErrors converts validator errors into the API's per-field error list
+func fieldErrors(verrs validator.ValidationErrors) []dto.FieldError {
+	fields := make([]dto.FieldError, 0, len(verrs))
+	for _, fe := range verrs {
+		fields = append(fields, dto.FieldError{
+			Field:   fe.Field(),
+			Rule:    fe.Tag(),
+			Message: fieldMessage(fe),
+		})
+	}
+	return fields
+}
+
+// fieldMessage returns a human readable message for a failed rule
+func fieldMessage(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return fmt.Sprintf("%s is required", fe.Field())
+	case "email":
+		return fmt.Sprintf("%s must be a valid email address", fe.Field())
+	case "min":
+		if fe.Kind() == reflect.String {
+			return fmt.Sprintf("%s must be at least %s characters", fe.Field(), fe.Param())
+		}
+		return fmt.Sprintf("%s must be at least %s", fe.Field(), fe.Param())
+	case "max":
+		if fe.Kind() == reflect.String {
+			return fmt.Sprintf("%s must be at most %s characters", fe.Field(), fe.Param())
+		}
+		return fmt.Sprintf("%s must be at most %s", fe.Field(), fe.Param())
+	case "oneof":
+		return fmt.Sprintf("%s must be one of: %s", fe.Field(), strings.ReplaceAll(fe.Param(), " ", ", "))
+	case "len":
+		return fmt.Sprintf("%s must be exactly %s characters", fe.Field(), fe.Param())
+	case "startswith":
+		return fmt.Sprintf("%s must start with %s", fe.Field(), fe.Param())
+	case "nefield":
+		return fmt.Sprintf("%s must differ from %s", fe.Field(), fe.Param())
+	case "numeric":
+		return fmt.Sprintf("%s must contain only digits", fe.Field())
+	case "scope":
+		return fmt.Sprintf("%s must be one of: %s", fe.Field(), strings.Join(middleware.APIKeyScopes, ", "))
+	case "slug":
+		return fmt.Sprintf("%s must contain only lower case letters and digits separated by single hyphens", fe.Field())
+	default:
+		return fmt.Sprintf("%s failed the %s rule", fe.Field(), fe.Tag())
+	}
+}
@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/config"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/passwordresettoken"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/user"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/mailer"
+)
+
+const (
+	emailVerificationTTL = 24 * time.Hour
+	passwordResetTTL     = 1 * time.Hour
+	purposeVerifyEmail   = "verify_email"
+
+	// emailResendCooldown is the minimum gap between requested verification or
+	// reset emails to one account, so the endpoints can't be used to flood an inbox
+	emailResendCooldown = 5 * time.Minute
+)
+
+// newVerificationToken returns a signed token proving ownership of u's current email.
+// The email is included so the token stops working once the address changes.
+func newVerificationToken(u *ent.User) (string, error) {
+	return signPurposeToken(purposeVerifyEmail, u.ID, u.Email, emailVerificationTTL)
+}
+
+// parseVerificationToken validates a verification token and returns the user ID and email it was issued for
+func parseVerificationToken(raw string) (int, string, error) {
+	claims, err := parsePurposeToken(raw, purposeVerifyEmail)
+	if err != nil {
+		return 0, "", err
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		return 0, "", fmt.Errorf("invalid subject: %w", err)
+	}
+	return id, claims.Email, nil
+}
+
+// sendVerificationEmail mails u a link to verify their email address and
+// records when it was sent
+func sendVerificationEmail(ctx context.Context, client *ent.Client, m mailer.Mailer, u *ent.User) error {
+	token, err := newVerificationToken(u)
+	if err != nil {
+		return err
+	}
+
+	if err := client.User.UpdateOneID(u.ID).SetVerificationSentAt(time.Now()).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to record verification email: %w", err)
+	}
+
+	link := fmt.Sprintf("%s/api/v1/auth/verify-email?token=%s", config.AppConfig.API.PublicURL, url.QueryEscape(token))
+	return m.Send(ctx, mailer.Message{
+		To:      u.Email,
+		Subject: "Verify your email address",
+		Body: fmt.Sprintf("Hi %s,\n\nPlease confirm your email address by opening the link below:\n\n%s\n\nThe link expires in %s.\n",
+			u.Username, link, emailVerificationTTL),
+	})
+}
+
+// sendPasswordResetEmail stores a new reset token for u, invalidating older ones, and mails the reset link.
+// Nothing is sent while a valid token issued within emailResendCooldown exists.
+func sendPasswordResetEmail(ctx context.Context, client *ent.Client, m mailer.Mailer, u *ent.User) error {
+	recent, err := client.PasswordResetToken.Query().
+		Where(
+			passwordresettoken.HasUserWith(user.ID(u.ID)),
+			passwordresettoken.UsedAtIsNil(),
+			passwordresettoken.ExpiresAtGT(time.Now()),
+			passwordresettoken.CreatedAtGT(time.Now().Add(-emailResendCooldown)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query reset tokens: %w", err)
+	}
+	if recent {
+		return nil
+	}
+
+	token, hash, err := newOpaqueToken()
+	if err != nil {
+		return err
+	}
+
+	// Only the most recently requested link stays valid
+	_, err = client.PasswordResetToken.Update().
+		Where(passwordresettoken.HasUserWith(user.ID(u.ID)), passwordresettoken.UsedAtIsNil()).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to invalidate reset tokens: %w", err)
+	}
+
+	err = client.PasswordResetToken.Create().
+		SetTokenHash(hash).
+		SetExpiresAt(time.Now().Add(passwordResetTTL)).
+		SetUserID(u.ID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to store reset token: %w", err)
+	}
+
+	link := fmt.Sprintf("%s/reset-password?token=%s", config.AppConfig.API.PublicURL, url.QueryEscape(token))
+	return m.Send(ctx, mailer.Message{
+		To:      u.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf("Hi %s,\n\nSomeone asked to reset the password of your account. Open the link below to choose a new one:\n\n%s\n\nThe link expires in %s. If you did not ask for this, you can ignore this email.\n",
+			u.Username, link, passwordResetTTL),
+	})
+}
@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/audit"
+	"github.com/gofiber/fiber/v2"
+)
+
+// AuditContext stores the client IP and request ID in the request context so
+// the audit hook can record them. Must run after the requestid middleware.
+func AuditContext() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		requestID, _ := c.Locals("requestid").(string)
+		c.SetUserContext(audit.WithActor(c.UserContext(), audit.Actor{
+			IP:        c.IP(),
+			RequestID: requestID,
+		}))
+		return c.Next()
+	}
+}
+
+// setAuditActor adds the authenticated user stored in locals to the audit actor
+func setAuditActor(c *fiber.Ctx) {
+	actor := audit.ActorFrom(c.UserContext())
+
+	// Anonymous and system changes stay without an actor ID
+	if userID, _ := c.Locals("user_id").(int64); userID != 0 {
+		id := int(userID)
+		actor.UserID = &id
+	}
+	actor.Username, _ = c.Locals("username").(string)
+	if keyID, ok := c.Locals("api_key_id").(int); ok {
+		actor.APIKeyID = &keyID
+	}
+
+	c.SetUserContext(audit.WithActor(c.UserContext(), actor))
+}
@@ -1,45 +1,37 @@
 package middleware
 
 import (
+	"context"
 	"fmt"
-	"os"
+	"slices"
+	"strings"
 
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/apierror"
 	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/rbac"
 	"github.com/casbin/casbin/v2"
 	"github.com/casbin/casbin/v2/model"
-	fileadapter "github.com/casbin/casbin/v2/persist/file-adapter"
 	"github.com/gofiber/fiber/v2"
 )
 
-var enforcer *casbin.Enforcer
+var enforcer *casbin.SyncedEnforcer
 
-// InitCasbin initializes the Casbin enforcer with embedded model and policy
-func InitCasbin() error {
+// InitCasbin initializes the Casbin enforcer with the embedded model and the
+// policies stored in the database, seeding them from policy.csv on first run
+func InitCasbin(client *ent.Client) error {
 	// Load model from embedded file
 	m, err := model.NewModelFromString(string(rbac.ModelConf))
 	if err != nil {
 		return fmt.Errorf("failed to load casbin model: %w", err)
 	}
 
-	// Create temp file for policy
-	tmpFile, err := os.CreateTemp("", "policy-*.csv")
-	if err != nil {
-		return fmt.Errorf("failed to create temp policy file: %w", err)
-	}
-	// defer os.Remove(tmpFile.Name()) // Keep file for now, or manage lifecycle
-
-	if _, err := tmpFile.Write(rbac.PolicyCSV); err != nil {
-		return fmt.Errorf("failed to write policy to temp file: %w", err)
-	}
-	if err := tmpFile.Close(); err != nil {
-		return fmt.Errorf("failed to close temp policy file: %w", err)
+	adapter := rbac.NewAdapter(client)
+	if err := adapter.Seed(context.Background()); err != nil {
+		return err
 	}
 
-	// Create adapter from temp file
-	adapter := fileadapter.NewAdapter(tmpFile.Name())
-
-	// Create enforcer
-	enforcer, err = casbin.NewEnforcer(m, adapter)
+	// Create enforcer; policy changes made through it are saved to the database
+	enforcer, err = casbin.NewSyncedEnforcer(m, adapter)
 	if err != nil {
 		return fmt.Errorf("failed to create casbin enforcer: %w", err)
 	}
@@ -52,35 +44,76 @@ func InitCasbin() error {
 	return nil
 }
 
+// Enforcer returns the shared Casbin enforcer
+func Enforcer() *casbin.SyncedEnforcer {
+	return enforcer
+}
+
+// ReloadPolicy re-reads all policies from the database, picking up changes
+// made by other instances or directly in the database
+func ReloadPolicy() error {
+	if err := enforcer.LoadPolicy(); err != nil {
+		return fmt.Errorf("failed to reload casbin policy: %w", err)
+	}
+	return nil
+}
+
 // CasbinMiddleware enforces RBAC authorization
 func CasbinMiddleware() fiber.Handler {
 	return func(c *fiber.Ctx) error {
-		// Get user role from context (set by JWT middleware)
-		role, ok := c.Locals("role").(string)
-		if !ok || role == "" {
-			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-				"error": "User role not found in token",
-			})
+		// Get user roles from context (set by JWT middleware)
+		roles := Roles(c)
+		if len(roles) == 0 {
+			return apierror.Unauthorized(apierror.CodeInvalidToken, "User roles not found in token")
 		}
 
 		// Get request path and method
 		path := c.Path()
 		method := c.Method()
 
-		// Check permission
-		allowed, err := enforcer.Enforce(role, path, method)
+		allowed, err := Allowed(c, path, method)
 		if err != nil {
-			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-				"error": "Failed to check permissions",
-			})
+			return apierror.Internal(err)
 		}
+		if allowed {
+			return c.Next()
+		}
+
+		return apierror.Forbidden(fmt.Sprintf("Access denied: roles %s cannot %s %s", strings.Join(roles, ", "), method, path))
+	}
+}
 
-		if !allowed {
-			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
-				"error": fmt.Sprintf("Access denied: %s role cannot %s %s", role, method, path),
-			})
+// Allowed reports whether any of the authenticated user's roles grants method on path
+func Allowed(c *fiber.Ctx, path, method string) (bool, error) {
+	for _, role := range Roles(c) {
+		allowed, err := enforcer.Enforce(role, path, method)
+		if err != nil {
+			return false, fmt.Errorf("failed to check permissions: %w", err)
+		}
+		if allowed {
+			return true, nil
 		}
+	}
+	return false, nil
+}
+
+// Roles returns the authenticated user's roles set by the JWT middleware
+func Roles(c *fiber.Ctx) []string {
+	roles, _ := c.Locals("roles").([]string)
+	return roles
+}
 
-		return c.Next()
+// HasRole reports whether the authenticated user has been assigned name,
+// directly or through a role inheriting from it
+func HasRole(c *fiber.Ctx, name string) bool {
+	for _, role := range Roles(c) {
+		if role == name {
+			return true
+		}
+		inherited, err := enforcer.GetImplicitRolesForUser(role)
+		if err == nil && slices.Contains(inherited, name) {
+			return true
+		}
 	}
+	return false
 }
@@ -1,93 +1,218 @@
 package middleware
 
 import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/config"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/revokedtoken"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/jwtkeys"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/apierror"
 	"github.com/gofiber/fiber/v2"
 	"github.com/golang-jwt/jwt/v5"
 )
 
 // JWTClaims represents JWT token claims
 type JWTClaims struct {
-	UserID   int64  `json:"user_id"`
-	Username string `json:"username"`
-	Role     string `json:"role"`
+	UserID   int64    `json:"user_id"`
+	Username string   `json:"username"`
+	Roles    []string `json:"roles"`
 	jwt.RegisteredClaims
 }
 
-// GenerateToken generates a JWT token for a user
-func GenerateToken(userID int64, username, role string) (string, error) {
-	// Parse expiry duration
+// AccessTokenTTL returns the configured access token lifetime
+func AccessTokenTTL() time.Duration {
 	expiry, err := time.ParseDuration(config.AppConfig.API.JWTExpiry)
 	if err != nil {
-		expiry = 24 * time.Hour // Default to 24 hours
+		expiry = 15 * time.Minute // Default to 15 minutes
+	}
+	return expiry
+}
+
+// RefreshTokenTTL returns the configured refresh token lifetime
+func RefreshTokenTTL() time.Duration {
+	expiry, err := time.ParseDuration(config.AppConfig.API.RefreshExpiry)
+	if err != nil {
+		expiry = 30 * 24 * time.Hour // Default to 30 days
+	}
+	return expiry
+}
+
+// GenerateToken generates a JWT token for a user
+func GenerateToken(userID int64, username string, roles []string) (string, error) {
+	jti, err := randomID()
+	if err != nil {
+		return "", err
 	}
 
 	claims := JWTClaims{
 		UserID:   userID,
 		Username: username,
-		Role:     role,
+		Roles:    roles,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(expiry)),
+			ID:        jti,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(AccessTokenTTL())),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			Issuer:    "crud-solution",
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(config.AppConfig.API.JWTSecret))
+	return SignClaims(claims)
+}
+
+// signingKeys holds the asymmetric keys; nil means HS256 with the shared jwt_secret
+var signingKeys atomic.Pointer[jwtkeys.Set]
+
+// InitJWTKeys loads the signing keys from jwt_keys_dir, if configured
+func InitJWTKeys() error {
+	if config.AppConfig.API.JWTKeysDir == "" {
+		return nil
+	}
+	if err := ReloadJWTKeys(); err != nil {
+		if errors.Is(err, jwtkeys.ErrNoActiveKey) {
+			return fmt.Errorf("%w: run \"services api keys rotate\" to create one", err)
+		}
+		return err
+	}
+	return nil
+}
+
+// ReloadJWTKeys re-reads jwt_keys_dir so keys rotated with the CLI are used without a restart
+func ReloadJWTKeys() error {
+	dir, err := config.ResolvePath(config.AppConfig.API.JWTKeysDir)
+	if err != nil {
+		return err
+	}
+	set, err := jwtkeys.Load(dir)
+	if err != nil {
+		return fmt.Errorf("failed to load jwt keys from %s: %w", dir, err)
+	}
+	signingKeys.Store(set)
+	return nil
+}
+
+// JWKS returns the public keys that verify issued tokens. It is empty in HS256 mode.
+func JWKS() jwtkeys.JWKS {
+	set := signingKeys.Load()
+	if set == nil {
+		return jwtkeys.JWKS{Keys: []jwtkeys.JWK{}}
+	}
+	return set.JWKS()
 }
 
-// JWTMiddleware validates JWT tokens
-func JWTMiddleware() fiber.Handler {
+// SignClaims signs claims with the active key, tagging the token with its kid
+func SignClaims(claims jwt.Claims) (string, error) {
+	set := signingKeys.Load()
+	if set == nil {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+		return token.SignedString([]byte(config.AppConfig.API.JWTSecret))
+	}
+
+	token := jwt.NewWithClaims(set.Active.Method(), claims)
+	token.Header["kid"] = set.Active.ID
+	return token.SignedString(set.Active.Private)
+}
+
+// ParseClaims verifies a token signed by SignClaims and decodes it into claims
+func ParseClaims(raw string, claims jwt.Claims, opts ...jwt.ParserOption) (*jwt.Token, error) {
+	return jwt.ParseWithClaims(raw, claims, verificationKey, opts...)
+}
+
+// verificationKey selects the key for a token from its kid header
+func verificationKey(token *jwt.Token) (interface{}, error) {
+	set := signingKeys.Load()
+	if set == nil {
+		// Validate signing method
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return []byte(config.AppConfig.API.JWTSecret), nil
+	}
+
+	kid, _ := token.Header["kid"].(string)
+	key, ok := set.Lookup(kid)
+	if !ok {
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+	if token.Method.Alg() != key.Algorithm {
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	}
+	return key.Public(), nil
+}
+
+// JWTMiddleware validates JWT tokens and rejects tokens whose jti has been revoked.
+// "Authorization: ApiKey <key>" is accepted as an alternative to a Bearer token.
+func JWTMiddleware(client *ent.Client) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		// Get Authorization header
 		authHeader := c.Get("Authorization")
 		if authHeader == "" {
-			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-				"error": "Missing authorization header",
-			})
+			return apierror.Unauthorized(apierror.CodeUnauthorized, "Missing authorization header")
 		}
 
-		// Check Bearer prefix
+		// Check Bearer or ApiKey prefix
 		parts := strings.Split(authHeader, " ")
-		if len(parts) != 2 || parts[0] != "Bearer" {
-			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-				"error": "Invalid authorization header format",
-			})
+		if len(parts) != 2 || (parts[0] != "Bearer" && parts[0] != "ApiKey") {
+			return apierror.Unauthorized(apierror.CodeUnauthorized, "Invalid authorization header format")
+		}
+
+		if parts[0] == "ApiKey" {
+			if err := authenticateAPIKey(c, client, parts[1]); err != nil {
+				return err
+			}
+			setAuditActor(c)
+			return c.Next()
 		}
 
 		tokenString := parts[1]
 
 		// Parse and validate token
-		token, err := jwt.ParseWithClaims(tokenString, &JWTClaims{}, func(token *jwt.Token) (interface{}, error) {
-			// Validate signing method
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-			}
-			return []byte(config.AppConfig.API.JWTSecret), nil
-		})
+		token, err := ParseClaims(tokenString, &JWTClaims{})
 
 		if err != nil {
-			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-				"error": "Invalid or expired token",
-			})
+			return apierror.Unauthorized(apierror.CodeInvalidToken, "Invalid or expired token")
 		}
 
 		// Extract claims
-		if claims, ok := token.Claims.(*JWTClaims); ok && token.Valid {
-			// Store user info in context
-			c.Locals("user_id", claims.UserID)
-			c.Locals("username", claims.Username)
-			c.Locals("role", claims.Role)
-			return c.Next()
+		claims, ok := token.Claims.(*JWTClaims)
+		if !ok || !token.Valid || claims.ID == "" || claims.ExpiresAt == nil {
+			return apierror.Unauthorized(apierror.CodeInvalidToken, "Invalid token claims")
+		}
+
+		// Check revocation list (populated on logout)
+		revoked, err := client.RevokedToken.Query().
+			Where(revokedtoken.Jti(claims.ID)).
+			Exist(context.Background())
+		if err != nil {
+			return apierror.Internal(fmt.Errorf("failed to check token revocation: %w", err))
+		}
+		if revoked {
+			return apierror.Unauthorized(apierror.CodeTokenRevoked, "Token has been revoked")
 		}
 
-		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-			"error": "Invalid token claims",
-		})
+		// Store user info in context
+		c.Locals("user_id", claims.UserID)
+		c.Locals("username", claims.Username)
+		c.Locals("roles", claims.Roles)
+		c.Locals("jti", claims.ID)
+		c.Locals("token_expires_at", claims.ExpiresAt.Time)
+		setAuditActor(c)
+		return c.Next()
+	}
+}
+
+// randomID returns a random 128-bit hex identifier
+func randomID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate token id: %w", err)
 	}
+	return hex.EncodeToString(b), nil
 }
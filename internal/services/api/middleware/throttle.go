@@ -0,0 +1,211 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/config"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/apierror"
+	"github.com/gofiber/fiber/v2"
+)
+
+// LockoutThreshold returns the number of consecutive failed logins that lock an account
+func LockoutThreshold() int {
+	if config.AppConfig.API.LockoutThreshold <= 0 {
+		return 5 // Default to 5 attempts
+	}
+	return config.AppConfig.API.LockoutThreshold
+}
+
+// LockoutDuration returns how long a locked account stays locked
+func LockoutDuration() time.Duration {
+	d, err := time.ParseDuration(config.AppConfig.API.LockoutDuration)
+	if err != nil || d <= 0 {
+		d = 15 * time.Minute // Default to 15 minutes
+	}
+	return d
+}
+
+// loginBackoff returns the configured per-IP base and maximum delay
+func loginBackoff() (base, max time.Duration) {
+	base, err := time.ParseDuration(config.AppConfig.API.LoginBackoffBase)
+	if err != nil || base <= 0 {
+		base = 1 * time.Second // Default to 1 second
+	}
+	max, err = time.ParseDuration(config.AppConfig.API.LoginBackoffMax)
+	if err != nil || max < base {
+		max = 5 * time.Minute // Default to 5 minutes
+	}
+	return base, max
+}
+
+// ipAttempts tracks consecutive failed logins from one IP
+type ipAttempts struct {
+	failures     int
+	blockedUntil time.Time
+	lastFailure  time.Time
+}
+
+// LoginThrottle applies an exponential backoff per client IP on failed logins.
+// State is kept in memory, so it is per process and reset on restart.
+type LoginThrottle struct {
+	mu        sync.Mutex
+	attempts  map[string]*ipAttempts
+	lastPrune time.Time
+}
+
+func NewLoginThrottle() *LoginThrottle {
+	return &LoginThrottle{attempts: make(map[string]*ipAttempts)}
+}
+
+// Handler rejects requests from an IP that is still backing off and records
+// the outcome of the wrapped login handler
+func (t *LoginThrottle) Handler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ip := c.IP()
+
+		if wait := t.retryAfter(ip); wait > 0 {
+			c.Set(fiber.HeaderRetryAfter, strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+			return apierror.New(fiber.StatusTooManyRequests, apierror.CodeTooManyRequests,
+				fmt.Sprintf("Too many failed login attempts, retry in %s", wait.Round(time.Second)))
+		}
+
+		err := c.Next()
+
+		var apiErr *apierror.Error
+		switch {
+		case err == nil:
+			t.reset(ip)
+		case errors.As(err, &apiErr) && (apiErr.Status == fiber.StatusUnauthorized || apiErr.Code == apierror.CodeAccountLocked):
+			t.fail(ip)
+		}
+		return err
+	}
+}
+
+// retryAfter returns how long ip must wait before it may try again
+func (t *LoginThrottle) retryAfter(ip string) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	a, ok := t.attempts[ip]
+	if !ok {
+		return 0
+	}
+	return time.Until(a.blockedUntil)
+}
+
+// fail records a failed attempt and doubles the delay for ip
+func (t *LoginThrottle) fail(ip string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	base, max := loginBackoff()
+	t.prune(now, max)
+
+	a, ok := t.attempts[ip]
+	if !ok || now.Sub(a.lastFailure) > max {
+		a = &ipAttempts{}
+		t.attempts[ip] = a
+	}
+	a.failures++
+	a.lastFailure = now
+
+	// The first failure is free; after that the delay doubles each time
+	if a.failures < 2 {
+		return
+	}
+	delay := max
+	if shift := a.failures - 2; shift < 32 {
+		if d := base << shift; d > 0 && d < max {
+			delay = d
+		}
+	}
+	a.blockedUntil = now.Add(delay)
+}
+
+// reset forgets ip after a successful login
+func (t *LoginThrottle) reset(ip string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.attempts, ip)
+}
+
+// prune drops IPs that have been quiet for longer than the maximum delay.
+// Called with t.mu held.
+func (t *LoginThrottle) prune(now time.Time, max time.Duration) {
+	if now.Sub(t.lastPrune) < time.Minute {
+		return
+	}
+	t.lastPrune = now
+
+	for ip, a := range t.attempts {
+		if now.Sub(a.lastFailure) > max && now.After(a.blockedUntil) {
+			delete(t.attempts, ip)
+		}
+	}
+}
+
+// ipWindow counts the requests of one IP in the current window
+type ipWindow struct {
+	count int
+	start time.Time
+}
+
+// RequestLimiter allows each client IP a fixed number of requests per window,
+// for public endpoints that send email. Like LoginThrottle it is in memory and per process.
+type RequestLimiter struct {
+	mu        sync.Mutex
+	limit     int
+	window    time.Duration
+	windows   map[string]*ipWindow
+	lastPrune time.Time
+}
+
+func NewRequestLimiter(limit int, window time.Duration) *RequestLimiter {
+	return &RequestLimiter{limit: limit, window: window, windows: make(map[string]*ipWindow)}
+}
+
+// Handler rejects requests beyond the limit until the IP's window has passed
+func (l *RequestLimiter) Handler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if wait := l.take(c.IP()); wait > 0 {
+			c.Set(fiber.HeaderRetryAfter, strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+			return apierror.New(fiber.StatusTooManyRequests, apierror.CodeTooManyRequests,
+				fmt.Sprintf("Too many requests, retry in %s", wait.Round(time.Second)))
+		}
+		return c.Next()
+	}
+}
+
+// take counts a request from ip and returns how long it must wait when over the limit
+func (l *RequestLimiter) take(ip string) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(l.lastPrune) >= l.window {
+		l.lastPrune = now
+		for k, w := range l.windows {
+			if now.Sub(w.start) >= l.window {
+				delete(l.windows, k)
+			}
+		}
+	}
+
+	w, ok := l.windows[ip]
+	if !ok || now.Sub(w.start) >= l.window {
+		w = &ipWindow{start: now}
+		l.windows[ip] = w
+	}
+	if w.count >= l.limit {
+		return w.start.Add(l.window).Sub(now)
+	}
+	w.count++
+	return 0
+}
@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/config"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/user"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/apierror"
+	"github.com/gofiber/fiber/v2"
+)
+
+// enrollmentPaths are reachable by admins who still have to enroll 2FA
+var enrollmentPaths = map[string]bool{
+	"/api/v1/me":            true,
+	"/api/v1/me/2fa/setup":  true,
+	"/api/v1/me/2fa/verify": true,
+}
+
+// TwoFactorEnrollment restricts admins without 2FA to the enrollment endpoints
+// when require_admin_2fa is set. Must run after JWTMiddleware.
+func TwoFactorEnrollment(client *ent.Client) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if !config.AppConfig.API.RequireAdmin2FA || !HasRole(c, "admin") {
+			return c.Next()
+		}
+		if enrollmentPaths[strings.TrimSuffix(c.Path(), "/")] {
+			return c.Next()
+		}
+
+		userID, _ := c.Locals("user_id").(int64)
+		enrolled, err := client.User.Query().
+			Where(user.ID(int(userID)), user.TotpEnabledAtNotNil()).
+			Exist(context.Background())
+		if err != nil {
+			return apierror.Internal(fmt.Errorf("failed to check 2FA enrollment: %w", err))
+		}
+		if !enrolled {
+			return apierror.New(fiber.StatusForbidden, apierror.Code2FARequired,
+				"Admin accounts must enable two-factor authentication via /me/2fa/setup")
+		}
+
+		return c.Next()
+	}
+}
@@ -0,0 +1,273 @@
+package rbac
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/casbinrule"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/predicate"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/role"
+	"github.com/casbin/casbin/v2/model"
+	"github.com/casbin/casbin/v2/persist"
+)
+
+// Adapter is a Casbin adapter storing policy lines in the casbin_rules table.
+// Role inheritance from the roles table is exposed as "g, child, parent" rules,
+// so adding or removing such a rule through Casbin edits the role's parents.
+type Adapter struct {
+	client *ent.Client
+}
+
+// NewAdapter creates an Ent-backed Casbin adapter
+func NewAdapter(client *ent.Client) *Adapter {
+	return &Adapter{client: client}
+}
+
+// Seed inserts the embedded policy.csv when the table is empty (first run).
+// Later changes to the defaults must be shipped as migrations.
+func (a *Adapter) Seed(ctx context.Context) error {
+	count, err := a.client.CasbinRule.Query().Count(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to count casbin rules: %w", err)
+	}
+	if count > 0 {
+		return nil
+	}
+
+	var builders []*ent.CasbinRuleCreate
+	scanner := bufio.NewScanner(bytes.NewReader(PolicyCSV))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, ",")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		builders = append(builders, newRule(a.client.CasbinRule, fields[0], fields[1:]))
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read embedded policy: %w", err)
+	}
+
+	if err := a.client.CasbinRule.CreateBulk(builders...).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to seed casbin rules: %w", err)
+	}
+	return nil
+}
+
+// LoadPolicy loads all policy rules from the database
+func (a *Adapter) LoadPolicy(m model.Model) error {
+	rules, err := a.client.CasbinRule.Query().
+		Order(ent.Asc(casbinrule.FieldID)).
+		All(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to load casbin rules: %w", err)
+	}
+
+	for _, r := range rules {
+		line := []string{r.Ptype, r.V0, r.V1, r.V2, r.V3, r.V4, r.V5}
+		// Drop unused trailing columns
+		for len(line) > 1 && line[len(line)-1] == "" {
+			line = line[:len(line)-1]
+		}
+		if err := persist.LoadPolicyArray(line, m); err != nil {
+			return err
+		}
+	}
+
+	inherited, err := a.roleParents(context.Background())
+	if err != nil {
+		return err
+	}
+	for edge := range inherited {
+		// A matching stored g rule is already loaded
+		if has, err := m.HasPolicy("g", "g", []string{edge[0], edge[1]}); err != nil {
+			return err
+		} else if has {
+			continue
+		}
+		if err := persist.LoadPolicyArray([]string{"g", edge[0], edge[1]}, m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// roleParents returns the (child, parent) role name pairs of the roles table
+func (a *Adapter) roleParents(ctx context.Context) (map[[2]string]bool, error) {
+	roles, err := a.client.Role.Query().WithParents().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load role inheritance: %w", err)
+	}
+
+	edges := make(map[[2]string]bool)
+	for _, r := range roles {
+		for _, p := range r.Edges.Parents {
+			edges[[2]string{r.Name, p.Name}] = true
+		}
+	}
+	return edges, nil
+}
+
+// roleEdge returns the two roles of a "g, child, parent" rule when both exist
+func (a *Adapter) roleEdge(ctx context.Context, ptype string, rule []string) (child, parent *ent.Role, err error) {
+	if ptype != "g" || len(rule) != 2 {
+		return nil, nil, nil
+	}
+
+	roles, err := a.client.Role.Query().Where(role.NameIn(rule[0], rule[1])).All(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to look up roles: %w", err)
+	}
+	for _, r := range roles {
+		switch r.Name {
+		case rule[0]:
+			child = r
+		case rule[1]:
+			parent = r
+		}
+	}
+	if child == nil || parent == nil {
+		return nil, nil, nil
+	}
+	return child, parent, nil
+}
+
+// SavePolicy replaces all stored rules with the rules of m
+func (a *Adapter) SavePolicy(m model.Model) error {
+	ctx := context.Background()
+	tx, err := a.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.CasbinRule.Delete().Exec(ctx); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to clear casbin rules: %w", err)
+	}
+
+	// Inheritance between roles lives in the roles table
+	inherited, err := a.roleParents(ctx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	var builders []*ent.CasbinRuleCreate
+	for _, sec := range []string{"p", "g"} {
+		for ptype, ast := range m[sec] {
+			for _, rule := range ast.Policy {
+				if ptype == "g" && len(rule) == 2 && inherited[[2]string{rule[0], rule[1]}] {
+					continue
+				}
+				builders = append(builders, newRule(tx.CasbinRule, ptype, rule))
+			}
+		}
+	}
+	if len(builders) > 0 {
+		if err := tx.CasbinRule.CreateBulk(builders...).Exec(ctx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to save casbin rules: %w", err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// AddPolicy adds a policy rule to the database
+func (a *Adapter) AddPolicy(sec string, ptype string, rule []string) error {
+	ctx := context.Background()
+
+	child, parent, err := a.roleEdge(ctx, ptype, rule)
+	if err != nil {
+		return err
+	}
+	if child != nil {
+		if err := child.Update().AddParents(parent).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to add parent role: %w", err)
+		}
+		return nil
+	}
+
+	if err := newRule(a.client.CasbinRule, ptype, rule).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to add casbin rule: %w", err)
+	}
+	return nil
+}
+
+// RemovePolicy removes a policy rule from the database
+func (a *Adapter) RemovePolicy(sec string, ptype string, rule []string) error {
+	ctx := context.Background()
+
+	child, parent, err := a.roleEdge(ctx, ptype, rule)
+	if err != nil {
+		return err
+	}
+	if child != nil {
+		if err := child.Update().RemoveParents(parent).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to remove parent role: %w", err)
+		}
+	}
+
+	_, err = a.client.CasbinRule.Delete().
+		Where(
+			casbinrule.Ptype(ptype),
+			casbinrule.V0(field(rule, 0)),
+			casbinrule.V1(field(rule, 1)),
+			casbinrule.V2(field(rule, 2)),
+			casbinrule.V3(field(rule, 3)),
+			casbinrule.V4(field(rule, 4)),
+			casbinrule.V5(field(rule, 5)),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to remove casbin rule: %w", err)
+	}
+	return nil
+}
+
+// RemoveFilteredPolicy removes the policy rules matching the filter. Empty
+// field values match anything.
+func (a *Adapter) RemoveFilteredPolicy(sec string, ptype string, fieldIndex int, fieldValues ...string) error {
+	columns := []func(string) predicate.CasbinRule{
+		casbinrule.V0, casbinrule.V1, casbinrule.V2, casbinrule.V3, casbinrule.V4, casbinrule.V5,
+	}
+
+	preds := []predicate.CasbinRule{casbinrule.Ptype(ptype)}
+	for i, v := range fieldValues {
+		if v != "" && fieldIndex+i < len(columns) {
+			preds = append(preds, columns[fieldIndex+i](v))
+		}
+	}
+
+	if _, err := a.client.CasbinRule.Delete().Where(preds...).Exec(context.Background()); err != nil {
+		return fmt.Errorf("failed to remove casbin rules: %w", err)
+	}
+	return nil
+}
+
+// newRule returns a builder for one policy line
+func newRule(client *ent.CasbinRuleClient, ptype string, rule []string) *ent.CasbinRuleCreate {
+	return client.Create().
+		SetPtype(ptype).
+		SetV0(field(rule, 0)).
+		SetV1(field(rule, 1)).
+		SetV2(field(rule, 2)).
+		SetV3(field(rule, 3)).
+		SetV4(field(rule, 4)).
+		SetV5(field(rule, 5))
+}
+
+// field returns rule[i] or "" when the rule is shorter
+func field(rule []string, i int) string {
+	if i < len(rule) {
+		return rule[i]
+	}
+	return ""
+}
@@ -3,37 +3,95 @@ package api
 import (
 	"os"
 	"path/filepath"
+	"time"
 
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/config"
 	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/mailer"
 	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/handlers"
 	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/middleware"
 	"github.com/gofiber/fiber/v2"
 )
 
 // RegisterRoutes registers all API routes
-func RegisterRoutes(app *fiber.App, client *ent.Client) {
+func RegisterRoutes(app *fiber.App, client *ent.Client, mail mailer.Mailer) {
 	// Handlers
-	authHandler := handlers.NewAuthHandler(client)
+	authHandler := handlers.NewAuthHandler(client, mail)
 	userHandler := handlers.NewUserHandler(client)
 	blogHandler := handlers.NewBlogHandler(client)
 	commentHandler := handlers.NewCommentHandler(client)
+	tagHandler := handlers.NewTagHandler(client)
+	categoryHandler := handlers.NewCategoryHandler(client)
+	addressHandler := handlers.NewAddressHandler(client)
+	meHandler := handlers.NewMeHandler(client, mail)
+	apiKeyHandler := handlers.NewAPIKeyHandler(client)
+	policyHandler := handlers.NewPolicyHandler(middleware.Enforcer())
+	roleHandler := handlers.NewRoleHandler(client, middleware.Enforcer())
+	authzHandler := handlers.NewAuthzHandler(middleware.Enforcer())
+	auditHandler := handlers.NewAuditHandler(client)
 
 	// Health Check
 	app.Get("/health", func(c *fiber.Ctx) error {
 		return c.JSON(fiber.Map{"status": "ok"})
 	})
 
+	// Public keys for verifying access tokens (empty when HS256 is used)
+	app.Get("/.well-known/jwks.json", func(c *fiber.Ctx) error {
+		c.Set(fiber.HeaderCacheControl, "public, max-age=300")
+		return c.JSON(middleware.JWKS())
+	})
+
 	// API Group
 	api := app.Group("/api/v1")
 
 	// Public Routes
 	auth := api.Group("/auth")
-	auth.Post("/login", authHandler.Login)
+	loginThrottle := middleware.NewLoginThrottle()
+	auth.Post("/login", loginThrottle.Handler(), authHandler.Login)
+	auth.Post("/2fa", loginThrottle.Handler(), authHandler.LoginTwoFactor)
+	auth.Post("/refresh", authHandler.Refresh)
+	auth.Post("/register", authHandler.Register)
+	auth.Get("/verify-email", authHandler.VerifyEmail)
+	// Endpoints that send email to a given address share a per-IP limit
+	emailLimiter := middleware.NewRequestLimiter(5, 15*time.Minute)
+	auth.Post("/verify-email/resend", emailLimiter.Handler(), authHandler.ResendVerification)
+	auth.Post("/password/forgot", emailLimiter.Handler(), authHandler.ForgotPassword)
+	auth.Post("/password/reset", authHandler.ResetPassword)
+
+	// Single sign-on through an external identity provider
+	if config.AppConfig.API.OIDC.Enabled {
+		oidcHandler := handlers.NewOIDCHandler(client, config.AppConfig.API.OIDC)
+		auth.Get("/oidc/login", oidcHandler.Login)
+		auth.Get("/oidc/callback", oidcHandler.Callback)
+	}
+
+	// Any authenticated user may log out, so only the JWT check applies
+	auth.Post("/logout", middleware.JWTMiddleware(client), authHandler.Logout)
 
 	// Protected Routes (JWT + Casbin)
 	// Apply JWT middleware to all routes that require authentication
+	// Apply 2FA enrollment check for admins when required by config
 	// Apply Casbin middleware to enforce RBAC
-	protected := api.Group("/", middleware.JWTMiddleware(), middleware.CasbinMiddleware())
+	protected := api.Group("/", middleware.JWTMiddleware(client), middleware.TwoFactorEnrollment(client), middleware.CasbinMiddleware())
+
+	// Self-service Routes (current user)
+	me := protected.Group("/me")
+	me.Get("/", meHandler.GetMe)
+	me.Patch("/", meHandler.UpdateMe)
+	me.Post("/password", meHandler.ChangePassword)
+	me.Post("/2fa/setup", meHandler.SetupTwoFactor)
+	me.Post("/2fa/verify", meHandler.VerifyTwoFactor)
+	me.Post("/2fa/disable", meHandler.DisableTwoFactor)
+	me.Get("/blogs", blogHandler.GetMyBlogs)
+	me.Get("/comments", commentHandler.GetMyComments)
+	me.Get("/addresses", addressHandler.GetMyAddresses)
+	me.Get("/api-keys", apiKeyHandler.GetMyAPIKeys)
+	me.Post("/api-keys", apiKeyHandler.CreateAPIKey)
+	me.Delete("/api-keys/:id", apiKeyHandler.DeleteAPIKey)
+	me.Get("/permissions", authzHandler.GetMyPermissions)
+
+	// Authorization checks for the current token
+	protected.Post("/authz/check", authzHandler.CheckPermissions)
 
 	// User Routes
 	users := protected.Group("/users")
@@ -42,6 +100,9 @@ func RegisterRoutes(app *fiber.App, client *ent.Client) {
 	users.Get("/:id", userHandler.GetUser)
 	users.Patch("/:id", userHandler.UpdateUser)
 	users.Delete("/:id", userHandler.DeleteUser)
+	users.Get("/:id/addresses", addressHandler.GetUserAddresses)
+	users.Post("/:id/unlock", userHandler.UnlockUser)
+	users.Post("/:id/restore", userHandler.RestoreUser)
 
 	// Blog Routes
 	blogs := protected.Group("/blogs")
@@ -50,6 +111,13 @@ func RegisterRoutes(app *fiber.App, client *ent.Client) {
 	blogs.Get("/:id", blogHandler.GetBlog)
 	blogs.Patch("/:id", blogHandler.UpdateBlog)
 	blogs.Delete("/:id", blogHandler.DeleteBlog)
+	blogs.Post("/:id/restore", blogHandler.RestoreBlog)
+	blogs.Post("/:id/publish", blogHandler.PublishBlog)
+	blogs.Post("/:id/unpublish", blogHandler.UnpublishBlog)
+	blogs.Get("/:id/comments", commentHandler.GetBlogComments)
+	blogs.Get("/:id/revisions", blogHandler.GetRevisions)
+	blogs.Get("/:id/revisions/:rev/diff", blogHandler.GetRevisionDiff)
+	blogs.Post("/:id/revisions/:rev/restore", blogHandler.RestoreRevision)
 
 	// Comment Routes
 	comments := protected.Group("/comments")
@@ -58,6 +126,53 @@ func RegisterRoutes(app *fiber.App, client *ent.Client) {
 	comments.Get("/:id", commentHandler.GetComment)
 	comments.Patch("/:id", commentHandler.UpdateComment)
 	comments.Delete("/:id", commentHandler.DeleteComment)
+	comments.Post("/:id/restore", commentHandler.RestoreComment)
+
+	// Address Routes
+	addresses := protected.Group("/addresses")
+	addresses.Get("/", addressHandler.GetAddresses)
+	addresses.Post("/", addressHandler.CreateAddress)
+	addresses.Get("/:id", addressHandler.GetAddress)
+	addresses.Patch("/:id", addressHandler.UpdateAddress)
+	addresses.Delete("/:id", addressHandler.DeleteAddress)
+
+	// Role Routes
+	roles := protected.Group("/roles")
+	roles.Get("/", roleHandler.GetRoles)
+	roles.Post("/", roleHandler.CreateRole)
+	roles.Get("/:id", roleHandler.GetRole)
+	roles.Patch("/:id", roleHandler.UpdateRole)
+	roles.Delete("/:id", roleHandler.DeleteRole)
+
+	// Tag Routes
+	tags := protected.Group("/tags")
+	tags.Get("/", tagHandler.GetTags)
+	tags.Post("/", tagHandler.CreateTag)
+	tags.Get("/counts", tagHandler.GetTagCounts)
+	tags.Get("/:id", tagHandler.GetTag)
+	tags.Patch("/:id", tagHandler.UpdateTag)
+	tags.Delete("/:id", tagHandler.DeleteTag)
+
+	// Category Routes
+	categories := protected.Group("/categories")
+	categories.Get("/", categoryHandler.GetCategories)
+	categories.Post("/", categoryHandler.CreateCategory)
+	categories.Get("/:id", categoryHandler.GetCategory)
+	categories.Patch("/:id", categoryHandler.UpdateCategory)
+	categories.Delete("/:id", categoryHandler.DeleteCategory)
+
+	// Admin Routes (permission management)
+	admin := protected.Group("/admin")
+	admin.Get("/policies", policyHandler.GetPolicies)
+	admin.Post("/policies", policyHandler.CreatePolicy)
+	admin.Delete("/policies", policyHandler.DeletePolicy)
+	admin.Post("/policies/reload", policyHandler.ReloadPolicies)
+	admin.Get("/role-assignments", policyHandler.GetRoleAssignments)
+	admin.Post("/role-assignments", policyHandler.CreateRoleAssignment)
+	admin.Delete("/role-assignments", policyHandler.DeleteRoleAssignment)
+
+	// Audit Log Routes
+	protected.Get("/audit", auditHandler.GetAuditLogs)
 
 	// Static File Serving for Frontend UI
 	// Get executable directory
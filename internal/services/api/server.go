@@ -6,13 +6,22 @@ import (
 	"log"
 	"time"
 
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/audit"
 	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/config"
 	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/blog"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/passwordresettoken"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/refreshtoken"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/revokedtoken"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/mailer"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/apierror"
 	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/services/api/middleware"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/softdelete"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
 	"github.com/gofiber/fiber/v2/middleware/logger"
 	"github.com/gofiber/fiber/v2/middleware/recover"
+	"github.com/gofiber/fiber/v2/middleware/requestid"
 
 	_ "github.com/go-sql-driver/mysql"
 )
@@ -32,6 +41,10 @@ type Server struct {
 // @in header
 // @name Authorization
 // @description Type "Bearer" followed by a space and JWT token
+// @securityDefinitions.apikey ApiKey
+// @in header
+// @name Authorization
+// @description Type "ApiKey" followed by a space and the API key
 func NewServer() *Server {
 	// Initialize Ent Client
 	dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?parseTime=True",
@@ -47,21 +60,44 @@ func NewServer() *Server {
 		log.Fatalf("failed opening connection to mysql: %v", err)
 	}
 
+	// Hide soft deleted rows unless a request asks for them
+	client.Intercept(softdelete.Interceptor())
+	client.Use(softdelete.Hook())
+
+	// Record changes in the audit log
+	client.Use(audit.Hook())
+
+	// Initialize Mailer
+	mail, err := mailer.New(config.AppConfig.Mail)
+	if err != nil {
+		log.Fatalf("failed to initialize mailer: %v", err)
+	}
+
 	// Initialize Fiber App
 	app := fiber.New(fiber.Config{
-		AppName: config.AppConfig.API.Name,
+		AppName:      config.AppConfig.API.Name,
+		ErrorHandler: apierror.Handler,
 	})
 
 	// Middleware
-	app.Use(logger.New())
+	app.Use(requestid.New())
+	app.Use(middleware.AuditContext())
+	app.Use(logger.New(logger.Config{
+		Format: "${time} | ${locals:requestid} | ${status} | ${latency} | ${ip} | ${method} | ${path} | ${error}\n",
+	}))
 	app.Use(recover.New())
 	app.Use(cors.New())
 
 	// Initialize Casbin
-	if err := middleware.InitCasbin(); err != nil {
+	if err := middleware.InitCasbin(client); err != nil {
 		log.Fatalf("failed to initialize casbin: %v", err)
 	}
 
+	// Load JWT signing keys
+	if err := middleware.InitJWTKeys(); err != nil {
+		log.Fatalf("failed to load jwt keys: %v", err)
+	}
+
 	// Create Server instance
 	server := &Server{
 		App:    app,
@@ -69,7 +105,7 @@ func NewServer() *Server {
 	}
 
 	// Register Routes
-	RegisterRoutes(app, client)
+	RegisterRoutes(app, client, mail)
 
 	// Conditionally register dev routes (Swagger)
 	RegisterDevRoutes(app)
@@ -82,6 +118,20 @@ func (s *Server) Start() error {
 	// Start health check in background
 	go s.StartHealthCheck()
 
+	// Purge expired tokens in background
+	go s.StartTokenCleanup()
+
+	// Pick up policy changes made by other instances in background
+	go s.StartPolicyReload()
+
+	// Publish scheduled blogs in background
+	go s.StartScheduledPublishing()
+
+	// Pick up rotated signing keys in background
+	if config.AppConfig.API.JWTKeysDir != "" {
+		go s.StartKeyReload()
+	}
+
 	addr := fmt.Sprintf("%s:%d", config.AppConfig.API.Host, config.AppConfig.API.Port)
 	return s.App.Listen(addr)
 }
@@ -127,6 +177,87 @@ func (s *Server) StartHealthCheck() {
 	}
 }
 
+// StartTokenCleanup periodically deletes expired refresh tokens, password reset tokens and revocation entries
+func (s *Server) StartTokenCleanup() {
+	ticker := time.NewTicker(1 * time.Hour)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if s.Client == nil {
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		now := time.Now()
+
+		if _, err := s.Client.RevokedToken.Delete().Where(revokedtoken.ExpiresAtLT(now)).Exec(ctx); err != nil {
+			log.Printf("Token cleanup failed (revoked tokens): %v", err)
+		}
+		if _, err := s.Client.RefreshToken.Delete().Where(refreshtoken.ExpiresAtLT(now)).Exec(ctx); err != nil {
+			log.Printf("Token cleanup failed (refresh tokens): %v", err)
+		}
+		if _, err := s.Client.PasswordResetToken.Delete().Where(passwordresettoken.ExpiresAtLT(now)).Exec(ctx); err != nil {
+			log.Printf("Token cleanup failed (password reset tokens): %v", err)
+		}
+
+		cancel()
+	}
+}
+
+// StartPolicyReload periodically reloads the Casbin policies from the database
+// so changes made through another instance take effect everywhere
+func (s *Server) StartPolicyReload() {
+	ticker := time.NewTicker(1 * time.Minute)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		// Keep the loaded policies if the database is temporarily unavailable
+		if err := middleware.ReloadPolicy(); err != nil {
+			log.Printf("Casbin policy reload failed: %v", err)
+		}
+	}
+}
+
+// StartScheduledPublishing periodically publishes the drafts whose scheduled
+// publish time has passed. Running it on several instances is harmless.
+func (s *Server) StartScheduledPublishing() {
+	ticker := time.NewTicker(1 * time.Minute)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if s.Client == nil {
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		n, err := s.Client.Blog.Update().
+			Where(blog.StatusEQ(blog.StatusDraft), blog.PublishedAtLTE(time.Now())).
+			SetStatus(blog.StatusPublished).
+			Save(ctx)
+		cancel()
+
+		if err != nil {
+			log.Printf("Scheduled publishing failed: %v", err)
+		} else if n > 0 {
+			log.Printf("Published %d scheduled blog(s)", n)
+		}
+	}
+}
+
+// StartKeyReload periodically reloads the JWT signing keys so a rotation done
+// with the CLI takes effect without a restart
+func (s *Server) StartKeyReload() {
+	ticker := time.NewTicker(1 * time.Minute)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		// Keep the previous keys if the directory is temporarily unreadable
+		if err := middleware.ReloadJWTKeys(); err != nil {
+			log.Printf("JWT key reload failed: %v", err)
+		}
+	}
+}
+
 // Shutdown gracefully shuts down the server
 func (s *Server) Shutdown() error {
 	if s.Client != nil {
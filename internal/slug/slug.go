@@ -0,0 +1,37 @@
+// Package slug turns names into URL friendly identifiers.
+package slug
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Make returns the lower case letters and digits of s with every other run of
+// characters replaced by a single hyphen, e.g. "Go & Fiber!" becomes "go-fiber".
+// The result is at most maxLen bytes long and never ends with a hyphen; maxLen <= 0
+// means no limit.
+func Make(s string, maxLen int) string {
+	var b strings.Builder
+	hyphen := false
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			hyphen = b.Len() > 0
+			continue
+		}
+		next := string(unicode.ToLower(r))
+		if hyphen {
+			next = "-" + next
+		}
+		if maxLen > 0 && b.Len()+len(next) > maxLen {
+			break
+		}
+		b.WriteString(next)
+		hyphen = false
+	}
+	return b.String()
+}
+
+// Valid reports whether s is already a slug
+func Valid(s string) bool {
+	return s != "" && Make(s, 0) == s
+}
@@ -0,0 +1,116 @@
+package slug
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMake(t *testing.T) {
+	tests := []struct {
+		name   string
+		in     string
+		maxLen int
+		want   string
+	}{
+		{
+			name: "lower cases and joins words",
+			in:   "Clean Code",
+			want: "clean-code",
+		},
+		{
+			name: "collapses punctuation runs",
+			in:   "Go & Fiber!",
+			want: "go-fiber",
+		},
+		{
+			name: "trims leading and trailing separators",
+			in:   "  --Hello, World--  ",
+			want: "hello-world",
+		},
+		{
+			name: "keeps digits",
+			in:   "Go 1.25 Release",
+			want: "go-1-25-release",
+		},
+		{
+			name: "folds unicode letters",
+			in:   "Ünïcode STRASSE Ελληνικά",
+			want: "ünïcode-strasse-ελληνικά",
+		},
+		{
+			name: "keeps letters without case",
+			in:   "日本語 テキスト",
+			want: "日本語-テキスト",
+		},
+		{
+			name: "punctuation only",
+			in:   "!?& -- ...",
+			want: "",
+		},
+		{
+			name: "empty",
+			in:   "",
+			want: "",
+		},
+		{
+			// Tag slugs are limited to 50 bytes
+			name:   "truncates to the limit",
+			in:     strings.Repeat("a", 60),
+			maxLen: 50,
+			want:   strings.Repeat("a", 50),
+		},
+		{
+			name:   "drops a hyphen at the limit",
+			in:     strings.Repeat("a", 49) + " b",
+			maxLen: 50,
+			want:   strings.Repeat("a", 49),
+		},
+		{
+			name:   "does not split multi-byte letters",
+			in:     strings.Repeat("a", 49) + "é",
+			maxLen: 50,
+			want:   strings.Repeat("a", 49),
+		},
+		{
+			name:   "no limit",
+			in:     strings.Repeat("a", 60),
+			maxLen: 0,
+			want:   strings.Repeat("a", 60),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Make(tt.in, tt.maxLen)
+			if got != tt.want {
+				t.Errorf("Make(%q, %d) = %q, want %q", tt.in, tt.maxLen, got, tt.want)
+			}
+			if tt.maxLen > 0 && len(got) > tt.maxLen {
+				t.Errorf("Make(%q, %d) is %d bytes long", tt.in, tt.maxLen, len(got))
+			}
+		})
+	}
+}
+
+func TestValid(t *testing.T) {
+	tests := []struct {
+		in   string
+		want bool
+	}{
+		{"clean-code", true},
+		{"go-1-25", true},
+		{"ünïcode", true},
+		{"", false},
+		{"Clean-Code", false},
+		{"clean--code", false},
+		{"-clean", false},
+		{"clean-", false},
+		{"clean code", false},
+	}
+
+	for _, tt := range tests {
+		if got := Valid(tt.in); got != tt.want {
+			t.Errorf("Valid(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
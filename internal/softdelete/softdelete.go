@@ -0,0 +1,81 @@
+// Package softdelete hides soft deleted users, blogs and comments, i.e. rows
+// with deleted_at set, from Ent queries and mutations.
+package softdelete
+
+import (
+	"context"
+
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/address"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/apikey"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/blog"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/comment"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/passwordresettoken"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/refreshtoken"
+	"github.com/AaronDevStack/CRUD_FullStackSolution/Backend/internal/database/ent/user"
+)
+
+type includeKey struct{}
+
+// IncludeDeleted returns a copy of ctx under which soft deleted rows are
+// visible to queries and may be updated or deleted
+func IncludeDeleted(ctx context.Context) context.Context {
+	return context.WithValue(ctx, includeKey{}, true)
+}
+
+// Included reports whether ctx was returned by IncludeDeleted
+func Included(ctx context.Context) bool {
+	included, _ := ctx.Value(includeKey{}).(bool)
+	return included
+}
+
+// Interceptor hides soft deleted rows from queries, including edge
+// traversals and eager loading. Rows owned by a soft deleted user (addresses,
+// API keys and tokens) are hidden as well.
+// Register it with client.Intercept so it applies to every query.
+func Interceptor() ent.Interceptor {
+	return ent.TraverseFunc(func(ctx context.Context, q ent.Query) error {
+		if Included(ctx) {
+			return nil
+		}
+		switch q := q.(type) {
+		case *ent.UserQuery:
+			q.Where(user.DeletedAtIsNil())
+		case *ent.BlogQuery:
+			q.Where(blog.DeletedAtIsNil())
+		case *ent.CommentQuery:
+			q.Where(comment.DeletedAtIsNil())
+		case *ent.AddressQuery:
+			q.Where(address.HasUserWith(user.DeletedAtIsNil()))
+		case *ent.APIKeyQuery:
+			q.Where(apikey.HasUserWith(user.DeletedAtIsNil()))
+		case *ent.RefreshTokenQuery:
+			q.Where(refreshtoken.HasUserWith(user.DeletedAtIsNil()))
+		case *ent.PasswordResetTokenQuery:
+			q.Where(passwordresettoken.HasUserWith(user.DeletedAtIsNil()))
+		}
+		return nil
+	})
+}
+
+// Hook keeps updates and deletes from touching soft deleted rows, so they
+// report not found like a query would.
+// Register it with client.Use before any hook that inspects the affected rows.
+func Hook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if Included(ctx) || m.Op().Is(ent.OpCreate) {
+				return next.Mutate(ctx, m)
+			}
+			switch m := m.(type) {
+			case *ent.UserMutation:
+				m.Where(user.DeletedAtIsNil())
+			case *ent.BlogMutation:
+				m.Where(blog.DeletedAtIsNil())
+			case *ent.CommentMutation:
+				m.Where(comment.DeletedAtIsNil())
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+}
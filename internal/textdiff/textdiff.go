@@ -0,0 +1,140 @@
+// Package textdiff computes line based diffs between two texts.
+package textdiff
+
+import (
+	"slices"
+	"strings"
+)
+
+// Op is the kind of a diff line
+type Op string
+
+const (
+	Equal  Op = "equal"  // Line is in both texts
+	Insert Op = "insert" // Line is only in the new text
+	Delete Op = "delete" // Line is only in the old text
+)
+
+// Line is one line of a diff
+type Line struct {
+	Op   Op
+	Text string
+}
+
+// MaxEdits bounds the edit distance searched for. Myers' algorithm keeps
+// O(D²) memory for D changed lines, so texts further apart than this are
+// diffed as all old lines deleted and all new lines inserted.
+const MaxEdits = 1000
+
+// Lines returns the shortest edit script turning before into after, line by
+// line. Common leading and trailing lines are always kept; if the lines in
+// between differ in more than MaxEdits places they are replaced as a whole.
+func Lines(before, after string) []Line {
+	a, b := split(before), split(after)
+
+	// Unchanged head and tail lines need no search
+	var head, tail []Line
+	for len(a) > 0 && len(b) > 0 && a[0] == b[0] {
+		head = append(head, Line{Equal, a[0]})
+		a, b = a[1:], b[1:]
+	}
+	for len(a) > 0 && len(b) > 0 && a[len(a)-1] == b[len(b)-1] {
+		tail = append(tail, Line{Equal, a[len(a)-1]})
+		a, b = a[:len(a)-1], b[:len(b)-1]
+	}
+	slices.Reverse(tail)
+
+	out := append(head, myers(a, b)...)
+	return append(out, tail...)
+}
+
+// myers returns the edit script between a and b, or replaces a with b when
+// that script is longer than MaxEdits
+func myers(a, b []string) []Line {
+	n, m := len(a), len(b)
+	limit := min(n+m, MaxEdits)
+	offset := limit + 1
+	// v[offset+k] is the furthest index into a reached on diagonal k
+	v := make([]int, 2*limit+3)
+
+	// trace[d] holds v[offset-d-1 : offset+d+2] before step d
+	var trace [][]int
+	for d := 0; d <= limit; d++ {
+		trace = append(trace, slices.Clone(v[offset-d-1:offset+d+2]))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				return backtrack(a, b, trace)
+			}
+		}
+	}
+	return replace(a, b)
+}
+
+// replace returns the edit script deleting all of a and inserting all of b
+func replace(a, b []string) []Line {
+	out := make([]Line, 0, len(a)+len(b))
+	for _, line := range a {
+		out = append(out, Line{Delete, line})
+	}
+	for _, line := range b {
+		out = append(out, Line{Insert, line})
+	}
+	return out
+}
+
+// backtrack walks the trace from the end of both texts back to the start
+func backtrack(a, b []string, trace [][]int) []Line {
+	var out []Line
+	x, y := len(a), len(b)
+	for d := len(trace) - 1; d >= 0; d-- {
+		v := trace[d]
+		at := func(k int) int { return v[k+d+1] }
+
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && at(k-1) < at(k+1)) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := at(prevK)
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			out = append(out, Line{Equal, a[x-1]})
+			x--
+			y--
+		}
+		if d > 0 {
+			if x == prevX {
+				out = append(out, Line{Insert, b[y-1]})
+			} else {
+				out = append(out, Line{Delete, a[x-1]})
+			}
+		}
+		x, y = prevX, prevY
+	}
+	slices.Reverse(out)
+	return out
+}
+
+// split returns the lines of s, ignoring a final line break
+func split(s string) []string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
@@ -0,0 +1,117 @@
+package textdiff
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		name          string
+		before, after string
+		want          []Line
+	}{
+		{
+			name: "empty",
+			want: nil,
+		},
+		{
+			name:   "identical",
+			before: "a\nb\nc\n",
+			after:  "a\nb\nc",
+			want:   []Line{{Equal, "a"}, {Equal, "b"}, {Equal, "c"}},
+		},
+		{
+			name:  "insert only",
+			after: "a\nb",
+			want:  []Line{{Insert, "a"}, {Insert, "b"}},
+		},
+		{
+			name:   "delete only",
+			before: "a\nb",
+			want:   []Line{{Delete, "a"}, {Delete, "b"}},
+		},
+		{
+			name:   "insert in middle",
+			before: "a\nc",
+			after:  "a\nb\nc",
+			want:   []Line{{Equal, "a"}, {Insert, "b"}, {Equal, "c"}},
+		},
+		{
+			name:   "delete in middle",
+			before: "a\nb\nc",
+			after:  "a\nc",
+			want:   []Line{{Equal, "a"}, {Delete, "b"}, {Equal, "c"}},
+		},
+		{
+			name:   "mixed",
+			before: "a\nb\nc\nd\ne",
+			after:  "a\nx\nc\ne\nf",
+			want: []Line{
+				{Equal, "a"}, {Delete, "b"}, {Insert, "x"}, {Equal, "c"},
+				{Delete, "d"}, {Equal, "e"}, {Insert, "f"},
+			},
+		},
+		{
+			name:   "crlf",
+			before: "a\r\nb\r\n",
+			after:  "a\nb\n",
+			want:   []Line{{Equal, "a"}, {Equal, "b"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Lines(tt.before, tt.after)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Lines(%q, %q) = %v, want %v", tt.before, tt.after, got, tt.want)
+			}
+			checkScript(t, got, tt.before, tt.after)
+		})
+	}
+}
+
+func TestLinesMaxEdits(t *testing.T) {
+	var before, after []string
+	for i := range MaxEdits {
+		before = append(before, fmt.Sprintf("old %d", i))
+		after = append(after, fmt.Sprintf("new %d", i))
+	}
+	head, tail := "head\n", "\ntail"
+	old := head + strings.Join(before, "\n") + tail
+	cur := head + strings.Join(after, "\n") + tail
+
+	got := Lines(old, cur)
+	checkScript(t, got, old, cur)
+
+	want := []Line{{Equal, "head"}}
+	for _, line := range before {
+		want = append(want, Line{Delete, line})
+	}
+	for _, line := range after {
+		want = append(want, Line{Insert, line})
+	}
+	want = append(want, Line{Equal, "tail"})
+	if !slices.Equal(got, want) {
+		t.Errorf("Lines over MaxEdits did not replace the changed lines as a whole")
+	}
+}
+
+// checkScript fails unless applying script to before yields after
+func checkScript(t *testing.T, script []Line, before, after string) {
+	t.Helper()
+	var old, cur []string
+	for _, line := range script {
+		if line.Op != Insert {
+			old = append(old, line.Text)
+		}
+		if line.Op != Delete {
+			cur = append(cur, line.Text)
+		}
+	}
+	if !slices.Equal(old, split(before)) || !slices.Equal(cur, split(after)) {
+		t.Errorf("edit script %v does not turn %q into %q", script, before, after)
+	}
+}